@@ -0,0 +1,96 @@
+/*
+adjacencymatrix.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements Graphs using Adjacency Matrix
+
+package adt
+
+// AdjacencyMatrix denotes a Directed Graph data structure, implemented using Adjacency Matrix
+// It suits the dense graphs, as HasEdge takes O(1) time, while Neighbors takes O(V) time & the memory is O(V^2).
+// There are no parallel edges: adding an existing edge again overwrites its weight.
+type AdjacencyMatrix struct {
+	// Matrix[u][v] tells whether the edge u -> v exists
+	Matrix [][]bool
+	// Weights[u][v] is the weight of the edge u -> v, if it exists
+	Weights [][]float64
+}
+
+// NewAdjacencyMatrix creates & returns a Directed Graph implemented using Adjacency Matrix
+func NewAdjacencyMatrix(numOfVertices int) *AdjacencyMatrix {
+	// init the matrices with Zeros of [numOfVertices X numOfVertices] matrix
+	m := &AdjacencyMatrix{Matrix: make([][]bool, numOfVertices), Weights: make([][]float64, numOfVertices)}
+	for idx := range m.Matrix {
+		m.Matrix[idx] = make([]bool, numOfVertices)
+		m.Weights[idx] = make([]float64, numOfVertices)
+	}
+	return m
+}
+
+// NumVertices returns the number of vertices in the graph
+func (m *AdjacencyMatrix) NumVertices() int {
+	return len(m.Matrix)
+}
+
+// Neighbors returns the vertices adjacent to the vertex (in ascending order) i.e. the heads of its outgoing edges
+// Time Complexity: O(V)
+func (m *AdjacencyMatrix) Neighbors(vertex int) []int {
+	neighbors := []int{}
+	for v, ok := range m.Matrix[vertex] {
+		if ok {
+			neighbors = append(neighbors, v)
+		}
+	}
+	return neighbors
+}
+
+// EdgeWeights returns the weights of the outgoing edges of the vertex, aligned with Neighbors(vertex)
+// Time Complexity: O(V)
+func (m *AdjacencyMatrix) EdgeWeights(vertex int) []float64 {
+	weights := []float64{}
+	for v, ok := range m.Matrix[vertex] {
+		if ok {
+			weights = append(weights, m.Weights[vertex][v])
+		}
+	}
+	return weights
+}
+
+// HasEdge tells whether the edge u -> v exists
+// Time Complexity: O(1)
+func (m *AdjacencyMatrix) HasEdge(u int, v int) bool {
+	return m.Matrix[u][v]
+}
+
+// EdgeWeight returns the weight of the edge u -> v and whether such an edge exists
+// Time Complexity: O(1)
+func (m *AdjacencyMatrix) EdgeWeight(u int, v int) (float64, bool) {
+	if !m.Matrix[u][v] {
+		return 0, false
+	}
+	return m.Weights[u][v], true
+}
+
+// AddEdge inserts edge to the directed graph
+// An unweighted edge is treated as an edge of weight 1 by the weighted algorithms
+func (m *AdjacencyMatrix) AddEdge(u int, v int) {
+	m.AddWeightedEdge(u, v, 1)
+}
+
+// AddWeightedEdge inserts an edge of the given weight (aka cost) to the directed graph
+// If the edge already exists, only its weight is updated.
+func (m *AdjacencyMatrix) AddWeightedEdge(u int, v int, weight float64) {
+	m.Matrix[u][v] = true
+	m.Weights[u][v] = weight
+}
+
+// RemoveEdge deletes the edge u -> v, and tells whether it existed
+func (m *AdjacencyMatrix) RemoveEdge(u int, v int) bool {
+	removed := m.Matrix[u][v]
+	m.Matrix[u][v] = false
+	m.Weights[u][v] = 0
+	return removed
+}
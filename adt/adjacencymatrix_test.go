@@ -0,0 +1,66 @@
+/*
+adjacencymatrix_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdjacencyMatrix_AddEdge(t *testing.T) {
+	m := NewAdjacencyMatrix(3)
+	m.AddEdge(0, 2)
+	m.AddWeightedEdge(0, 1, 2.5)
+	// no parallel edges, the weight is overwritten
+	m.AddWeightedEdge(0, 1, 1.5)
+
+	assert.Equal(t, 3, m.NumVertices())
+	assert.Equal(t, []int{1, 2}, m.Neighbors(0))
+	assert.Equal(t, []float64{1.5, 1}, m.EdgeWeights(0))
+	assert.Equal(t, []int{}, m.Neighbors(1))
+	assert.True(t, m.HasEdge(0, 1))
+	assert.False(t, m.HasEdge(1, 0))
+
+	weight, ok := m.EdgeWeight(0, 1)
+	assert.True(t, ok)
+	assert.Equal(t, 1.5, weight)
+
+	assert.True(t, m.RemoveEdge(0, 1))
+	assert.False(t, m.RemoveEdge(0, 1))
+	assert.False(t, m.HasEdge(0, 1))
+	_, ok = m.EdgeWeight(0, 1)
+	assert.False(t, ok)
+}
+
+func TestAdjacencyMatrix_Algorithms(t *testing.T) {
+	// the same graph as in TestGraph_DFS & TestGraph_TopoSort
+	m := NewAdjacencyMatrix(5)
+	m.AddEdge(0, 3)
+	m.AddEdge(3, 4)
+	m.AddEdge(4, 1)
+	m.AddEdge(2, 1)
+	m.AddEdge(2, 0)
+
+	assert.Equal(t, []int{0, 3, 4, 1, 2}, DFS(m))
+	order, hasCycle := TopoSort(m)
+	assert.False(t, hasCycle)
+	assert.Equal(t, []int{2, 0, 3, 4, 1}, order)
+
+	distances, predecessors, err := Dijkstra(m, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{1, 1, 0, 2, 3}, distances)
+	assert.Equal(t, []int{2, 0, 3}, PathTo(predecessors, 2, 3))
+
+	m.AddEdge(1, 2)
+	cycle, hasCycle := FindCycle(m)
+	assert.True(t, hasCycle)
+	assertSameCycle(t, []int{0, 3, 4, 1, 2}, cycle)
+	_, count := StronglyConnectedComponents(m)
+	assert.Equal(t, 1, count)
+}
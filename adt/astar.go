@@ -0,0 +1,113 @@
+/*
+astar.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements the A* search of Graphs
+
+package adt
+
+import (
+	"math"
+
+	"github.com/toransahu/goutils/adt/heap"
+	myerr "github.com/toransahu/goutils/errors"
+)
+
+var ERR_EXPANSION_LIMIT_REACHED myerr.UserDefinedError = "expansion limit reached"
+
+// AStarResult is the outcome of an A* search
+type AStarResult struct {
+	// Path holds the vertices of the shortest path from the source to the target, nil if not found
+	Path []int
+	// Cost is the weight of the path, +Inf if not found
+	Cost float64
+	// Expanded is the number of vertices expanded i.e. whose outgoing edges were relaxed
+	Expanded int
+	// Generated is the number of entries pushed to the priority queue
+	Generated int
+}
+
+// AStar finds the shortest (least weight) path from the source to the target vertex using A* search
+// The heuristic estimates the cost from a vertex to the target, guiding the search towards the target;
+// so fewer vertices are expanded than by Dijkstra (which is A* with a zero heuristic i.e. a nil heuristic).
+// The path is the shortest, if the heuristic is admissible i.e. it never overestimates the cost
+// (e.g. the straight line distance on a map). A vertex is expanded again, if a cheaper path to it is found later;
+// which happens only if the heuristic is not consistent i.e. h(u) > w(u, v) + h(v) for an edge u -> v.
+// The search fails with ERR_EXPANSION_LIMIT_REACHED once maxExpansions vertices are expanded (0 means no limit),
+// still returning the statistics. It fails with ERR_NEGATIVE_EDGE_WEIGHT on expanding a negative edge.
+// Time Complexity: O((V + E) log V) with a consistent heuristic
+func AStar(g GraphInterface, source int, target int, heuristic func(vertex int) float64, maxExpansions int) (*AStarResult, error) {
+	if source < 0 || source >= g.NumVertices() || target < 0 || target >= g.NumVertices() {
+		return nil, ERR_VERTEX_OUT_OF_RANGE
+	}
+	if heuristic == nil {
+		heuristic = func(vertex int) float64 { return 0 }
+	}
+	result := &AStarResult{Cost: math.Inf(1)}
+
+	// to store the cost of the cheapest path found so far from the source to each vertex
+	costs := make([]float64, g.NumVertices())
+	// to store the predecessor of each vertex in the cheapest path found so far
+	predecessors := make([]int, g.NumVertices())
+	for vertex := range costs {
+		costs[vertex] = math.Inf(1)
+		predecessors[vertex] = -1
+	}
+	// to cache the heuristic of each vertex
+	estimates := map[int]float64{}
+	estimate := func(vertex int) float64 {
+		if h, found := estimates[vertex]; found {
+			return h
+		}
+		estimates[vertex] = heuristic(vertex)
+		return estimates[vertex]
+	}
+
+	// a min-heap of the (vertex, cost + heuristic) pairs, used as a priority queue
+	pq := &vertexDistanceArray{}
+	costs[source] = 0
+	heap.Insert(pq, vertexDistance{vertex: source, distance: estimate(source)})
+	result.Generated++
+
+	for pq.Len() > 0 {
+		top := heap.DeleteTop(pq).(vertexDistance)
+		// the heap may hold stale entries of a vertex reached by a cheaper path later (lazy deletion); skip them
+		if top.distance > costs[top.vertex]+estimate(top.vertex) {
+			continue
+		}
+		if top.vertex == target {
+			result.Path = PathTo(predecessors, source, target)
+			result.Cost = costs[target]
+			return result, nil
+		}
+		if maxExpansions > 0 && result.Expanded == maxExpansions {
+			return result, ERR_EXPANSION_LIMIT_REACHED
+		}
+		result.Expanded++
+
+		// relax all the outgoing edges of the vertex
+		weights := g.EdgeWeights(top.vertex)
+		for idx, neighbor := range g.Neighbors(top.vertex) {
+			if weights[idx] < 0 {
+				return result, ERR_NEGATIVE_EDGE_WEIGHT
+			}
+			cost := costs[top.vertex] + weights[idx]
+			if cost < costs[neighbor] {
+				costs[neighbor] = cost
+				predecessors[neighbor] = top.vertex
+				heap.Insert(pq, vertexDistance{vertex: neighbor, distance: cost + estimate(neighbor)})
+				result.Generated++
+			}
+		}
+	}
+	// the target is unreachable
+	return result, nil
+}
+
+// AStar is a shorthand for AStar(g, source, target, heuristic, maxExpansions)
+func (g *Graph) AStar(source int, target int, heuristic func(vertex int) float64, maxExpansions int) (*AStarResult, error) {
+	return AStar(g, source, target, heuristic, maxExpansions)
+}
@@ -0,0 +1,112 @@
+/*
+astar_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newGrid (private func) returns a grid of the given size, where the vertex of the cell (row, col) is row*size + col
+// and the neighboring cells are connected both ways, except the walls
+func newGrid(size int, walls map[int]bool) *Graph {
+	g := NewGraph(size * size)
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			vertex := row*size + col
+			if walls[vertex] {
+				continue
+			}
+			if col+1 < size && !walls[vertex+1] {
+				g.AddEdge(vertex, vertex+1)
+				g.AddEdge(vertex+1, vertex)
+			}
+			if row+1 < size && !walls[vertex+size] {
+				g.AddEdge(vertex, vertex+size)
+				g.AddEdge(vertex+size, vertex)
+			}
+		}
+	}
+	return g
+}
+
+func TestGraph_AStar(t *testing.T) {
+	size := 20
+	// a wall across the middle, with a gap at the right end
+	walls := map[int]bool{}
+	for col := 0; col < size-1; col++ {
+		walls[10*size+col] = true
+	}
+	g := newGrid(size, walls)
+	source, target := 0, (size-1)*size
+	manhattan := func(vertex int) float64 {
+		row, col := vertex/size, vertex%size
+		return math.Abs(float64(row-size+1)) + math.Abs(float64(col))
+	}
+
+	result, err := g.AStar(source, target, manhattan, 0)
+	assert.Nil(t, err)
+	distances, _, _ := g.Dijkstra(source)
+	assert.Equal(t, distances[target], result.Cost)
+	assert.Equal(t, 2*(size-1)+size-1, int(result.Cost))
+	assert.Equal(t, source, result.Path[0])
+	assert.Equal(t, target, result.Path[len(result.Path)-1])
+	assert.Equal(t, int(result.Cost)+1, len(result.Path))
+
+	// the heuristic expands fewer vertices than Dijkstra (i.e. a nil heuristic)
+	dijkstra, err := AStar(g, source, target, nil, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, result.Cost, dijkstra.Cost)
+	assert.Less(t, result.Expanded, dijkstra.Expanded)
+	assert.GreaterOrEqual(t, result.Generated, result.Expanded)
+
+	// the expansion limit
+	limited, err := g.AStar(source, target, manhattan, 10)
+	assert.Equal(t, ERR_EXPANSION_LIMIT_REACHED, err)
+	assert.Equal(t, 10, limited.Expanded)
+	assert.Nil(t, limited.Path)
+	assert.True(t, math.IsInf(limited.Cost, 1))
+
+	// unreachable, by closing the gap
+	walls[10*size+size-1] = true
+	result, err = newGrid(size, walls).AStar(source, target, manhattan, 0)
+	assert.Nil(t, err)
+	assert.Nil(t, result.Path)
+	assert.True(t, math.IsInf(result.Cost, 1))
+
+	_, err = g.AStar(source, size*size, manhattan, 0)
+	assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err)
+}
+
+func TestGraph_AStar_InconsistentHeuristic(t *testing.T) {
+	/*
+		0 --1--> 1 --1--> 3 --3--> 4
+		0 --1--> 2 --2--> 3
+		h(1) = 4 is admissible (the cost from 1 to 4 is 4) but not consistent, as h(1) > w(1, 3) + h(3)
+		so 3 is expanded first via 2, and again once the cheaper path via 1 is found
+	*/
+	g := NewGraph(5)
+	g.AddWeightedEdge(0, 1, 1)
+	g.AddWeightedEdge(0, 2, 1)
+	g.AddWeightedEdge(1, 3, 1)
+	g.AddWeightedEdge(2, 3, 2)
+	g.AddWeightedEdge(3, 4, 3)
+	h := []float64{0, 4, 1, 0, 0}
+
+	result, err := g.AStar(0, 4, func(vertex int) float64 { return h[vertex] }, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1, 3, 4}, result.Path)
+	assert.Equal(t, 5.0, result.Cost)
+	assert.Equal(t, 5, result.Expanded)
+
+	g.AddWeightedEdge(4, 0, -1)
+	_, err = g.AStar(4, 3, nil, 0)
+	assert.Equal(t, ERR_NEGATIVE_EDGE_WEIGHT, err)
+}
@@ -0,0 +1,152 @@
+/*
+biconnected.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements Articulation Points, Bridges & Biconnected Components of Undirected Graphs
+
+package adt
+
+// ArticulationPoints finds the articulation points (aka cut vertices) of the undirected graph
+// i.e. the vertices whose removal increases the number of connected components.
+// It returns the vertices in ascending order.
+// Time Complexity: O(V + E)
+func ArticulationPoints(g GraphInterface) []int {
+	l := lowLink(g)
+	result := []int{}
+	for vertex, isArticulation := range l.articulation {
+		if isArticulation {
+			result = append(result, vertex)
+		}
+	}
+	return result
+}
+
+// ArticulationPoints is a shorthand for ArticulationPoints(g)
+func (g *UndirectedGraph) ArticulationPoints() []int {
+	return ArticulationPoints(g)
+}
+
+// Bridges finds the bridges (aka cut edges) of the undirected graph
+// i.e. the edges whose removal increases the number of connected components.
+// Time Complexity: O(V + E)
+func Bridges(g GraphInterface) []Edge {
+	return lowLink(g).bridges
+}
+
+// Bridges is a shorthand for Bridges(g)
+func (g *UndirectedGraph) Bridges() []Edge {
+	return Bridges(g)
+}
+
+// BiconnectedComponents finds the biconnected components (aka blocks) of the undirected graph
+// i.e. the maximal sets of edges, where any two edges lie on a common simple cycle (or the set is a single bridge).
+// It returns the edges of each component; the isolated vertices & self loops are not part of any component.
+// Time Complexity: O(V + E)
+func BiconnectedComponents(g GraphInterface) [][]Edge {
+	return lowLink(g).components
+}
+
+// BiconnectedComponents is a shorthand for BiconnectedComponents(g)
+func (g *UndirectedGraph) BiconnectedComponents() [][]Edge {
+	return BiconnectedComponents(g)
+}
+
+// lowLinkState holds the state & results of Tarjan's low-link analysis of an undirected graph
+type lowLinkState struct {
+	graph GraphInterface
+	// the order (starting from 1) in which each vertex is discovered, 0 if not yet visited
+	index   []int
+	counter int
+	// the lowest index reachable from each vertex, through its DFS subtree & at most one back edge
+	low []int
+	// the edges of the biconnected component being explored
+	edges []Edge
+
+	articulation []bool
+	bridges      []Edge
+	components   [][]Edge
+}
+
+// lowLink (private func) runs Tarjan's low-link analysis over all the connected components of the graph
+func lowLink(g GraphInterface) *lowLinkState {
+	l := &lowLinkState{
+		graph:        g,
+		index:        make([]int, g.NumVertices()),
+		low:          make([]int, g.NumVertices()),
+		articulation: make([]bool, g.NumVertices()),
+		bridges:      []Edge{},
+		components:   [][]Edge{},
+	}
+	// as the graph may be disconnected, iterate over all the vertices
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		if l.index[vertex] != 0 {
+			continue
+		}
+		// the root of a DFS tree is an articulation point only if it has more than one child
+		if l.visit(vertex, -1) > 1 {
+			l.articulation[vertex] = true
+		}
+	}
+	return l
+}
+
+// visit (private func) runs the DFS of the low-link analysis from the given vertex, reached from the parent
+// It returns the number of the children of the vertex in the DFS tree.
+func (l *lowLinkState) visit(vertex int, parent int) int {
+	l.counter++
+	l.index[vertex] = l.counter
+	l.low[vertex] = l.counter
+	children := 0
+
+	// whether the edge back to the parent (the one we came through) has been skipped already
+	// any other edge to the parent is a parallel edge, and so a back edge
+	skippedParentEdge := false
+	weights := l.graph.EdgeWeights(vertex)
+	for idx, u := range l.graph.Neighbors(vertex) {
+		if u == parent && !skippedParentEdge {
+			skippedParentEdge = true
+			continue
+		}
+		// self loops do not affect the connectivity
+		if u == vertex {
+			continue
+		}
+		edge := Edge{From: vertex, To: u, Weight: weights[idx]}
+
+		if l.index[u] == 0 {
+			// a tree edge
+			children++
+			mark := len(l.edges)
+			l.edges = append(l.edges, edge)
+			l.visit(u, vertex)
+			if l.low[u] < l.low[vertex] {
+				l.low[vertex] = l.low[u]
+			}
+
+			// the subtree of u can not reach above the vertex, so the vertex separates it from the rest
+			if l.low[u] >= l.index[vertex] {
+				if parent != -1 {
+					l.articulation[vertex] = true
+				}
+				// the edges explored since the tree edge form a biconnected component
+				component := append([]Edge{}, l.edges[mark:]...)
+				l.edges = l.edges[:mark]
+				l.components = append(l.components, component)
+			}
+			// the subtree of u can not even reach the vertex, except via the tree edge
+			if l.low[u] > l.index[vertex] {
+				l.bridges = append(l.bridges, edge)
+			}
+		} else if l.index[u] < l.index[vertex] {
+			// a back edge to an ancestor (the same edge seen from the ancestor's side is skipped)
+			l.edges = append(l.edges, edge)
+			if l.index[u] < l.low[vertex] {
+				l.low[vertex] = l.index[u]
+			}
+		}
+	}
+	return children
+}
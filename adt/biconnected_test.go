@@ -0,0 +1,103 @@
+/*
+biconnected_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newBiconnectedTestGraph creates the graph:
+//
+//	0 --- 1 --- 3 --- 4
+//	 \   /      |
+//	  \ /       |
+//	   2        5 === 6   (5 - 6 are parallel edges)
+//
+//	7 (isolated)
+func newBiconnectedTestGraph() *UndirectedGraph {
+	g := NewUndirectedGraph(8)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(1, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(3, 5)
+	g.AddEdge(5, 6)
+	g.AddEdge(6, 5)
+	return g
+}
+
+func TestUndirectedGraph_ArticulationPoints(t *testing.T) {
+	g := newBiconnectedTestGraph()
+	assert.Equal(t, []int{1, 3, 5}, g.ArticulationPoints())
+
+	// a cycle has none
+	g = NewUndirectedGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	assert.Equal(t, []int{}, g.ArticulationPoints())
+}
+
+func TestUndirectedGraph_Bridges(t *testing.T) {
+	g := newBiconnectedTestGraph()
+	got := [][2]int{}
+	for _, edge := range g.Bridges() {
+		got = append(got, normalizedPair(edge))
+	}
+	sort.Slice(got, func(i, j int) bool { return got[i][0] < got[j][0] || got[i][0] == got[j][0] && got[i][1] < got[j][1] })
+	assert.Equal(t, [][2]int{{1, 3}, {3, 4}, {3, 5}}, got)
+}
+
+func TestUndirectedGraph_BiconnectedComponents(t *testing.T) {
+	g := newBiconnectedTestGraph()
+	components := g.BiconnectedComponents()
+	assert.Len(t, components, 5)
+
+	// collect the vertices of each component
+	got := map[[2]int]int{}
+	sizes := []int{}
+	for idx, component := range components {
+		for _, edge := range component {
+			got[normalizedPair(edge)] = idx
+		}
+		sizes = append(sizes, len(component))
+	}
+	// the triangle is one component
+	assert.Equal(t, got[[2]int{0, 1}], got[[2]int{1, 2}])
+	assert.Equal(t, got[[2]int{0, 1}], got[[2]int{0, 2}])
+	// the bridges are a component each
+	assert.NotEqual(t, got[[2]int{1, 3}], got[[2]int{3, 4}])
+	assert.NotEqual(t, got[[2]int{3, 4}], got[[2]int{3, 5}])
+	sort.Ints(sizes)
+	assert.Equal(t, []int{1, 1, 1, 2, 3}, sizes)
+}
+
+func TestGraph_Undirected(t *testing.T) {
+	g := NewGraph(3)
+	g.AddWeightedEdge(0, 1, 2)
+	g.AddWeightedEdge(1, 0, 1)
+	g.AddEdge(1, 2)
+
+	undirected := g.Undirected()
+	assert.Equal(t, [][]int{{1}, {0, 2}, {1}}, undirected.AdjacencyList)
+	weight, _ := undirected.EdgeWeight(0, 1)
+	assert.Equal(t, 1.0, weight)
+	assert.Equal(t, []int{1}, undirected.ArticulationPoints())
+}
+
+// normalizedPair returns the vertices of the undirected edge as (smaller, larger)
+func normalizedPair(edge Edge) [2]int {
+	if edge.From > edge.To {
+		return [2]int{edge.To, edge.From}
+	}
+	return [2]int{edge.From, edge.To}
+}
@@ -0,0 +1,179 @@
+/*
+closure.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements the Transitive Closure & Transitive Reduction of Graphs
+
+package adt
+
+import "math/bits"
+
+// Reachability is the transitive closure of a directed graph i.e. which vertices are reachable from which
+// It holds a bitset row per strongly connected component, as all the vertices of a component reach the same vertices.
+type Reachability struct {
+	// the component of each vertex
+	component []int
+	// the vertices reachable from each component
+	rows []bitSet
+}
+
+// TransitiveClosure computes the transitive closure of the directed graph (cyclic or not)
+// i.e. the vertex v is reachable from u, if there is a path (of at least one edge) u -> ... -> v.
+// So a vertex reaches itself only if it is on a cycle.
+// Time Complexity: O(V + E) for the components, plus O(E * V / 64) for OR-ing the bitset rows
+func TransitiveClosure(g GraphInterface) *Reachability {
+	component, count := StronglyConnectedComponents(g)
+	r := &Reachability{component: component, rows: make([]bitSet, count)}
+
+	// the members of each component, and whether the component has a cycle
+	members := make([][]int, count)
+	cyclic := make([]bool, count)
+	for vertex, c := range component {
+		members[c] = append(members[c], vertex)
+	}
+	for c := range members {
+		r.rows[c] = newBitSet(g.NumVertices())
+		cyclic[c] = len(members[c]) > 1
+	}
+
+	// the components are numbered in a topological order, so compute the rows in the reverse order
+	// i.e. the successors of a component are done before it
+	for c := count - 1; c >= 0; c-- {
+		row := r.rows[c]
+		for _, vertex := range members[c] {
+			for _, u := range g.Neighbors(vertex) {
+				d := component[u]
+				if d == c {
+					// a self loop, or an edge within a component
+					cyclic[c] = true
+					continue
+				}
+				row.or(r.rows[d])
+				row.set(u)
+			}
+		}
+		if cyclic[c] {
+			for _, vertex := range members[c] {
+				row.set(vertex)
+			}
+		}
+	}
+	return r
+}
+
+// TransitiveClosure is a shorthand for TransitiveClosure(g)
+func (g *Graph) TransitiveClosure() *Reachability {
+	return TransitiveClosure(g)
+}
+
+// Reachable tells whether there is a path (of at least one edge) from u to v
+// Time Complexity: O(1)
+func (r *Reachability) Reachable(u int, v int) bool {
+	return r.rows[r.component[u]].has(v)
+}
+
+// ReachableFrom returns the vertices reachable from the vertex, in ascending order
+func (r *Reachability) ReachableFrom(vertex int) []int {
+	return r.rows[r.component[vertex]].members()
+}
+
+// Graph returns the transitive closure as a graph having an edge u -> v for every v reachable from u
+func (r *Reachability) Graph() *Graph {
+	g := NewGraph(len(r.component))
+	for u := range r.component {
+		for _, v := range r.ReachableFrom(u) {
+			g.AddEdge(u, v)
+		}
+	}
+	return g
+}
+
+// TransitiveReduction computes the transitive reduction of the directed acyclic graph (DAG)
+// i.e. the graph with the fewest edges having the same reachability, which is unique for a DAG.
+// An edge u -> v is dropped, if v is reachable from u through another path; e.g. of a -> b -> c & a -> c,
+// the edge a -> c is dropped. Of the parallel edges, the lightest one is kept.
+// It returns a new graph, or a *CycleError if the graph has a cycle.
+// Time Complexity: O(V + E) for the components, plus O(E * V / 64) for OR-ing the bitset rows
+func TransitiveReduction(g GraphInterface) (*Graph, error) {
+	if cycle, hasCycle := FindCycle(g); hasCycle {
+		return nil, &CycleError{Cycle: cycle}
+	}
+	closure := TransitiveClosure(g)
+	reduction := NewGraph(g.NumVertices())
+
+	for u := 0; u < g.NumVertices(); u++ {
+		neighbors, weights := g.Neighbors(u), g.EdgeWeights(u)
+		// the vertices reachable through the neighbors i.e. through the paths of at least two edges
+		indirect := newBitSet(g.NumVertices())
+		// to hold the lightest edge to each neighbor
+		lightest := map[int]float64{}
+		// to keep the edges in a deterministic order
+		order := []int{}
+		for idx, v := range neighbors {
+			weight, found := lightest[v]
+			if !found {
+				order = append(order, v)
+				indirect.or(closure.rows[closure.component[v]])
+			}
+			if !found || weights[idx] < weight {
+				lightest[v] = weights[idx]
+			}
+		}
+		for _, v := range order {
+			if !indirect.has(v) {
+				reduction.AddWeightedEdge(u, v, lightest[v])
+			}
+		}
+	}
+	return reduction, nil
+}
+
+// TransitiveReduction is a shorthand for TransitiveReduction(g)
+func (g *Graph) TransitiveReduction() (*Graph, error) {
+	return TransitiveReduction(g)
+}
+
+/*
+ HELPERS
+*/
+
+// bitSet is a set of the integers 0..n-1, packed into the bits of the words
+type bitSet []uint64
+
+// newBitSet (private func) creates & returns an empty set of the integers 0..n-1
+func newBitSet(n int) bitSet {
+	return make(bitSet, (n+63)/64)
+}
+
+// set (private func) adds the integer to the set
+func (b bitSet) set(i int) {
+	b[i/64] |= 1 << uint(i%64)
+}
+
+// has (private func) tells whether the integer is a member of the set
+func (b bitSet) has(i int) bool {
+	return b[i/64]&(1<<uint(i%64)) != 0
+}
+
+// or (private func) adds all the members of the other set of the same size
+func (b bitSet) or(other bitSet) {
+	for idx := range b {
+		b[idx] |= other[idx]
+	}
+}
+
+// members (private func) returns the members of the set, in ascending order
+func (b bitSet) members() []int {
+	members := []int{}
+	for idx, word := range b {
+		for word != 0 {
+			members = append(members, idx*64+bits.TrailingZeros64(word))
+			// clear the lowest set bit
+			word &= word - 1
+		}
+	}
+	return members
+}
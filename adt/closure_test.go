@@ -0,0 +1,98 @@
+/*
+closure_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_TransitiveClosure(t *testing.T) {
+	/*
+		0 -> 1 -> 2 <-> 3 -> 4
+		5 -> 5 (self loop)
+		6 (isolated)
+	*/
+	g := NewGraph(7)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 2)
+	g.AddEdge(3, 4)
+	g.AddEdge(5, 5)
+
+	closure := g.TransitiveClosure()
+	assert.Equal(t, []int{1, 2, 3, 4}, closure.ReachableFrom(0))
+	// a vertex on a cycle reaches itself
+	assert.Equal(t, []int{2, 3, 4}, closure.ReachableFrom(2))
+	assert.Equal(t, []int{2, 3, 4}, closure.ReachableFrom(3))
+	assert.Equal(t, []int{}, closure.ReachableFrom(4))
+	assert.Equal(t, []int{5}, closure.ReachableFrom(5))
+	assert.Equal(t, []int{}, closure.ReachableFrom(6))
+
+	assert.True(t, closure.Reachable(0, 4))
+	assert.False(t, closure.Reachable(4, 0))
+	assert.False(t, closure.Reachable(0, 0))
+	assert.True(t, closure.Reachable(2, 2))
+
+	// agrees with BFS on every pair
+	for u := 0; u < g.Vertices; u++ {
+		distances, _, _ := g.BFS(u)
+		for v := 0; v < g.Vertices; v++ {
+			if u != v {
+				assert.Equal(t, distances[v] != -1, closure.Reachable(u, v), "%d -> %d", u, v)
+			}
+		}
+	}
+
+	assert.Equal(t, [][]int{{1, 2, 3, 4}, {2, 3, 4}, {2, 3, 4}, {2, 3, 4}, {}, {5}, {}}, closure.Graph().AdjacencyList)
+}
+
+func TestGraph_TransitiveClosure_Large(t *testing.T) {
+	// a chain spanning many words of the bitset rows
+	n := 200
+	g := NewGraph(n)
+	for u := 0; u+1 < n; u++ {
+		g.AddEdge(u, u+1)
+	}
+	closure := TransitiveClosure(g)
+	assert.Len(t, closure.ReachableFrom(0), n-1)
+	assert.True(t, closure.Reachable(3, n-1))
+	assert.False(t, closure.Reachable(n-1, 3))
+}
+
+func TestGraph_TransitiveReduction(t *testing.T) {
+	/*
+		app -> lib -> core
+		app -> core (redundant)
+		app -> util -> core
+		lib -> core (parallel, heavier)
+	*/
+	g := NewGraph(4)
+	g.AddWeightedEdge(0, 1, 1)
+	g.AddWeightedEdge(1, 2, 2)
+	g.AddWeightedEdge(0, 2, 3)
+	g.AddWeightedEdge(0, 3, 4)
+	g.AddWeightedEdge(3, 2, 5)
+	g.AddWeightedEdge(1, 2, 6)
+
+	reduction, err := g.TransitiveReduction()
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int{{1, 3}, {2}, {}, {2}}, reduction.AdjacencyList)
+	assert.Equal(t, [][]float64{{1, 4}, {2}, {}, {5}}, reduction.Weights)
+	// the same reachability
+	assert.Equal(t, g.TransitiveClosure().Graph(), reduction.TransitiveClosure().Graph())
+	// the source graph is not affected
+	assert.Equal(t, 3, g.OutDegree(0))
+
+	g.AddEdge(2, 0)
+	_, err = TransitiveReduction(g)
+	assert.True(t, errors.Is(err, ERR_GRAPH_HAS_CYCLE))
+}
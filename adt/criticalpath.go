@@ -0,0 +1,141 @@
+/*
+criticalpath.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements the Critical Path Method (CPM) & the Longest Path of directed acyclic graphs (DAG)
+
+package adt
+
+import (
+	"math"
+
+	myerr "github.com/toransahu/goutils/errors"
+)
+
+var ERR_INVALID_DURATIONS myerr.UserDefinedError = "durations must be given for all the vertices"
+
+// Schedule is the outcome of the critical path analysis of a DAG of steps (vertices) & their dependencies (edges)
+type Schedule struct {
+	// EarliestStart holds the earliest time each step can start, i.e. once all of its predecessors are done
+	EarliestStart []float64
+	// LatestStart holds the latest time each step can start, without delaying the whole schedule
+	LatestStart []float64
+	// Slack holds how much each step can be delayed, without delaying the whole schedule (LatestStart - EarliestStart)
+	Slack []float64
+	// Duration is the total time taken by the whole schedule
+	Duration float64
+	// CriticalPath holds the chain of steps deciding the Duration, each of them having no slack
+	CriticalPath []int
+}
+
+// CriticalPath runs the critical path analysis of the directed acyclic graph (DAG), building on TopoSort
+// The durations are either given per vertex (e.g. the time taken by a build step), or else (if nil)
+// taken from the edge weights (e.g. the time taken by the step u -> v); not both.
+// i.e. with the vertex durations, a step v starts after its predecessors u finish (time EarliestStart[u] + durations[u]);
+// and with the edge durations, a step v starts after the edges u -> v are done (time EarliestStart[u] + weight).
+// It returns the schedule, or a *CycleError if the graph has a cycle.
+// Time Complexity: O(V + E)
+func CriticalPath(g GraphInterface, durations []float64) (*Schedule, error) {
+	if durations != nil && len(durations) != g.NumVertices() {
+		return nil, ERR_INVALID_DURATIONS
+	}
+	order, err := TopoSortWithError(g)
+	if err != nil {
+		return nil, err
+	}
+
+	// duration returns the time between the start of u & the start of its successor via the edge u -> v of the weight
+	duration := func(u int, weight float64) float64 {
+		if durations != nil {
+			return durations[u]
+		}
+		return weight
+	}
+	// finish returns the time taken by the step itself
+	finish := func(vertex int) float64 {
+		if durations != nil {
+			return durations[vertex]
+		}
+		return 0
+	}
+
+	n := g.NumVertices()
+	s := &Schedule{
+		EarliestStart: make([]float64, n),
+		LatestStart:   make([]float64, n),
+		Slack:         make([]float64, n),
+		CriticalPath:  []int{},
+	}
+	// the predecessor of each vertex deciding its earliest start, -1 if none
+	predecessors := make([]int, n)
+	for vertex := range predecessors {
+		predecessors[vertex] = -1
+	}
+
+	// forward pass: the earliest start of each vertex, in topological order
+	// (each vertex is seeded with 0, i.e. a path may start there, so a predecessor reaching it earlier than 0 is not taken;
+	// otherwise a negative duration would pull the vertex & all of its successors back)
+	for _, u := range order {
+		weights := g.EdgeWeights(u)
+		for idx, v := range g.Neighbors(u) {
+			start := s.EarliestStart[u] + duration(u, weights[idx])
+			if start > s.EarliestStart[v] || (start == s.EarliestStart[v] && predecessors[v] == -1) {
+				s.EarliestStart[v] = start
+				predecessors[v] = u
+			}
+		}
+	}
+
+	// the whole schedule ends with the step finishing last
+	last := -1
+	for vertex := 0; vertex < n; vertex++ {
+		if end := s.EarliestStart[vertex] + finish(vertex); last == -1 || end > s.Duration {
+			s.Duration = end
+			last = vertex
+		}
+	}
+
+	// backward pass: the latest start of each vertex, in reverse topological order
+	for idx := len(order) - 1; idx >= 0; idx-- {
+		u := order[idx]
+		latest := s.Duration - finish(u)
+		weights := g.EdgeWeights(u)
+		for idx, v := range g.Neighbors(u) {
+			latest = math.Min(latest, s.LatestStart[v]-duration(u, weights[idx]))
+		}
+		s.LatestStart[u] = latest
+		s.Slack[u] = latest - s.EarliestStart[u]
+	}
+
+	// walk back the predecessors from the last step, which are tight (i.e. critical) by construction
+	// (up to whichever step having no predecessor, so any root is accepted)
+	if last != -1 {
+		s.CriticalPath = PathTo(predecessors, -1, last)
+	}
+	return s, nil
+}
+
+// CriticalPath is a shorthand for CriticalPath(g, durations)
+func (g *Graph) CriticalPath(durations []float64) (*Schedule, error) {
+	return CriticalPath(g, durations)
+}
+
+// LongestPath finds the longest (most weight) path of the directed acyclic graph (DAG)
+// The path may start & end at any vertex, so it leaves out any prefix of negative weight (e.g. 0 -> 1 (-5) -> 2 (10) gives 1 -> 2).
+// It returns the vertices of the path & its weight, or a *CycleError if the graph has a cycle.
+// Time Complexity: O(V + E)
+func LongestPath(g GraphInterface) ([]int, float64, error) {
+	s, err := CriticalPath(g, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.CriticalPath, s.Duration, nil
+}
+
+// LongestPath is a shorthand for LongestPath(g)
+func (g *Graph) LongestPath() ([]int, float64, error) {
+	return LongestPath(g)
+}
@@ -0,0 +1,105 @@
+/*
+criticalpath_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_CriticalPath(t *testing.T) {
+	/*
+		checkout(1) -> compile(5) -> test(3) -> deploy(2)
+		checkout(1) -> lint(2) -> test(3)
+		docs(1)
+	*/
+	g := NewGraph(6)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+
+	// the durations per vertex, so the edge weights are ignored
+	s, err := g.CriticalPath([]float64{1, 5, 2, 3, 2, 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 11.0, s.Duration)
+	assert.Equal(t, []float64{0, 1, 1, 6, 9, 0}, s.EarliestStart)
+	assert.Equal(t, []float64{0, 1, 4, 6, 9, 10}, s.LatestStart)
+	assert.Equal(t, []float64{0, 0, 3, 0, 0, 10}, s.Slack)
+	assert.Equal(t, []int{0, 1, 3, 4}, s.CriticalPath)
+
+	_, err = g.CriticalPath([]float64{1})
+	assert.Equal(t, ERR_INVALID_DURATIONS, err)
+}
+
+func TestGraph_CriticalPath_EdgeDurations(t *testing.T) {
+	// the durations per edge
+	g := NewGraph(4)
+	g.AddWeightedEdge(0, 1, 3)
+	g.AddWeightedEdge(0, 2, 2)
+	g.AddWeightedEdge(1, 3, 4)
+	g.AddWeightedEdge(2, 3, 1)
+
+	s, err := CriticalPath(g, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 7.0, s.Duration)
+	assert.Equal(t, []float64{0, 3, 2, 7}, s.EarliestStart)
+	assert.Equal(t, []float64{0, 3, 6, 7}, s.LatestStart)
+	assert.Equal(t, []float64{0, 0, 4, 0}, s.Slack)
+	assert.Equal(t, []int{0, 1, 3}, s.CriticalPath)
+
+	path, weight, err := g.LongestPath()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1, 3}, path)
+	assert.Equal(t, 7.0, weight)
+
+	// an empty graph
+	s, err = NewGraph(0).CriticalPath(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, s.Duration)
+	assert.Equal(t, []int{}, s.CriticalPath)
+
+	g.AddEdge(3, 0)
+	_, _, err = LongestPath(g)
+	assert.True(t, errors.Is(err, ERR_GRAPH_HAS_CYCLE))
+}
+
+func TestGraph_LongestPath_NegativeWeights(t *testing.T) {
+	// the negative edge is left out, as the path may start after it
+	g := NewGraph(3)
+	g.AddWeightedEdge(0, 1, -5)
+	g.AddWeightedEdge(1, 2, 10)
+
+	path, weight, err := g.LongestPath()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2}, path)
+	assert.Equal(t, 10.0, weight)
+
+	// a negative edge in the middle is taken, if it pays off
+	g = NewGraph(4)
+	g.AddWeightedEdge(0, 1, 6)
+	g.AddWeightedEdge(1, 2, -2)
+	g.AddWeightedEdge(2, 3, 5)
+
+	path, weight, err = LongestPath(g)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3}, path)
+	assert.Equal(t, 9.0, weight)
+
+	// all negative, so the longest path is a single vertex
+	g = NewGraph(2)
+	g.AddWeightedEdge(0, 1, -1)
+
+	path, weight, err = LongestPath(g)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0}, path)
+	assert.Equal(t, 0.0, weight)
+}
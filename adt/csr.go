@@ -0,0 +1,125 @@
+/*
+csr.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements Graphs using Compressed Sparse Row (CSR)
+
+package adt
+
+import "sort"
+
+// CSRGraph denotes an immutable Directed Graph data structure, implemented using Compressed Sparse Row (CSR)
+// All the edges are packed into flat arrays, sorted by their tail & then head vertex, i.e. the outgoing edges of
+// the vertex u are Targets[Offsets[u]:Offsets[u+1]]. It suits the huge read-only graphs, as it takes
+// the least memory and HasEdge takes O(log(degree)) time. It implements GraphInterface, but not MutableGraphInterface.
+type CSRGraph struct {
+	Offsets []int
+	Targets []int
+	// Weights holds the weight of each edge, aligned with Targets
+	Weights []float64
+}
+
+// NewCSRGraph creates & returns a CSR copy of the given graph
+// Time Complexity: O(V + E*log(E))
+func NewCSRGraph(g GraphInterface) *CSRGraph {
+	edges := []Edge{}
+	for u := 0; u < g.NumVertices(); u++ {
+		neighbors, weights := g.Neighbors(u), g.EdgeWeights(u)
+		for idx, v := range neighbors {
+			edges = append(edges, Edge{From: u, To: v, Weight: weights[idx]})
+		}
+	}
+	return newCSRGraph(g.NumVertices(), edges)
+}
+
+// NewCSRGraphFromEdges creates & returns a CSR graph of the given number of vertices & edges
+// Parallel edges are kept, ordered by their weights.
+// It returns ERR_VERTEX_OUT_OF_RANGE, if an edge has an endpoint out of 0..numOfVertices-1.
+// Time Complexity: O(V + E*log(E))
+func NewCSRGraphFromEdges(numOfVertices int, edges []Edge) (*CSRGraph, error) {
+	for _, edge := range edges {
+		if edge.From < 0 || edge.From >= numOfVertices || edge.To < 0 || edge.To >= numOfVertices {
+			return nil, ERR_VERTEX_OUT_OF_RANGE
+		}
+	}
+	return newCSRGraph(numOfVertices, edges), nil
+}
+
+// newCSRGraph (private func) builds the CSR graph, assuming the endpoints of the edges are valid
+func newCSRGraph(numOfVertices int, edges []Edge) *CSRGraph {
+	sorted := append([]Edge{}, edges...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].From != sorted[j].From {
+			return sorted[i].From < sorted[j].From
+		}
+		if sorted[i].To != sorted[j].To {
+			return sorted[i].To < sorted[j].To
+		}
+		return sorted[i].Weight < sorted[j].Weight
+	})
+
+	g := &CSRGraph{
+		Offsets: make([]int, numOfVertices+1),
+		Targets: make([]int, len(sorted)),
+		Weights: make([]float64, len(sorted)),
+	}
+	// count the outgoing edges of each vertex, then accumulate the counts into the offsets
+	for _, edge := range sorted {
+		g.Offsets[edge.From+1]++
+	}
+	for u := 0; u < numOfVertices; u++ {
+		g.Offsets[u+1] += g.Offsets[u]
+	}
+	for idx, edge := range sorted {
+		g.Targets[idx] = edge.To
+		g.Weights[idx] = edge.Weight
+	}
+	return g
+}
+
+// NumVertices returns the number of vertices in the graph
+func (g *CSRGraph) NumVertices() int {
+	return len(g.Offsets) - 1
+}
+
+// NumEdges returns the number of edges in the graph
+func (g *CSRGraph) NumEdges() int {
+	return len(g.Targets)
+}
+
+// Neighbors returns the vertices adjacent to the vertex (in ascending order) i.e. the heads of its outgoing edges
+// The returned slice shares the memory of the graph, so it must not be modified.
+func (g *CSRGraph) Neighbors(vertex int) []int {
+	start, end := g.Offsets[vertex], g.Offsets[vertex+1]
+	return g.Targets[start:end:end]
+}
+
+// EdgeWeights returns the weights of the outgoing edges of the vertex, aligned with Neighbors(vertex)
+// The returned slice shares the memory of the graph, so it must not be modified.
+func (g *CSRGraph) EdgeWeights(vertex int) []float64 {
+	start, end := g.Offsets[vertex], g.Offsets[vertex+1]
+	return g.Weights[start:end:end]
+}
+
+// HasEdge tells whether the edge u -> v exists, using binary search
+// Time Complexity: O(log(degree of u))
+func (g *CSRGraph) HasEdge(u int, v int) bool {
+	neighbors := g.Neighbors(u)
+	idx := sort.SearchInts(neighbors, v)
+	return idx < len(neighbors) && neighbors[idx] == v
+}
+
+// EdgeWeight returns the weight of the edge u -> v and whether such an edge exists
+// If there are parallel edges u -> v, the weight of the lightest one is returned
+// Time Complexity: O(log(degree of u))
+func (g *CSRGraph) EdgeWeight(u int, v int) (float64, bool) {
+	neighbors := g.Neighbors(u)
+	idx := sort.SearchInts(neighbors, v)
+	if idx == len(neighbors) || neighbors[idx] != v {
+		return 0, false
+	}
+	return g.EdgeWeights(u)[idx], true
+}
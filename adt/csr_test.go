@@ -0,0 +1,110 @@
+/*
+csr_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCSRGraph(t *testing.T) {
+	g := NewGraph(4)
+	g.AddWeightedEdge(0, 3, 3)
+	g.AddWeightedEdge(0, 1, 1)
+	g.AddWeightedEdge(2, 1, 4)
+	g.AddWeightedEdge(0, 1, 0.5)
+
+	c := NewCSRGraph(g)
+	assert.Equal(t, 4, c.NumVertices())
+	assert.Equal(t, 4, c.NumEdges())
+	assert.Equal(t, []int{0, 3, 3, 4, 4}, c.Offsets)
+	// the rows are sorted, and the parallel edges by their weights
+	assert.Equal(t, []int{1, 1, 3}, c.Neighbors(0))
+	assert.Equal(t, []float64{0.5, 1, 3}, c.EdgeWeights(0))
+	assert.Equal(t, []int{}, c.Neighbors(1))
+
+	assert.True(t, c.HasEdge(0, 3))
+	assert.True(t, c.HasEdge(2, 1))
+	assert.False(t, c.HasEdge(0, 2))
+	assert.False(t, c.HasEdge(3, 0))
+
+	weight, ok := c.EdgeWeight(0, 1)
+	assert.True(t, ok)
+	assert.Equal(t, 0.5, weight)
+	_, ok = c.EdgeWeight(1, 0)
+	assert.False(t, ok)
+
+	// the source graph is not affected
+	assert.Equal(t, []int{3, 1, 1}, g.Neighbors(0))
+}
+
+func TestNewCSRGraphFromEdges(t *testing.T) {
+	c, err := NewCSRGraphFromEdges(3, []Edge{{From: 1, To: 2, Weight: 3}, {From: 1, To: 0, Weight: 1}})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 0, 2, 2}, c.Offsets)
+	assert.Equal(t, []int{0, 2}, c.Targets)
+	assert.Equal(t, []float64{1, 3}, c.Weights)
+
+	for _, edge := range []Edge{{From: 3, To: 0}, {From: 0, To: 3}, {From: -1, To: 0}, {From: 0, To: -1}} {
+		_, err := NewCSRGraphFromEdges(3, []Edge{{From: 0, To: 1}, edge})
+		assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err, edge)
+	}
+}
+
+func TestCSRGraph_IsImmutable(t *testing.T) {
+	var c interface{} = NewCSRGraph(NewGraph(2))
+	_, isGraph := c.(GraphInterface)
+	assert.True(t, isGraph)
+	_, isMutable := c.(MutableGraphInterface)
+	assert.False(t, isMutable)
+
+	for _, g := range []interface{}{NewGraph(2), NewUndirectedGraph(2), NewAdjacencyMatrix(2)} {
+		_, isMutable = g.(MutableGraphInterface)
+		assert.True(t, isMutable)
+	}
+}
+
+func TestCSRGraph_Algorithms(t *testing.T) {
+	// the same graph as in TestGraph_AllPairsShortestPaths
+	g := NewGraph(4)
+	g.AddWeightedEdge(0, 1, 3)
+	g.AddWeightedEdge(0, 2, 8)
+	g.AddWeightedEdge(1, 2, -2)
+	g.AddWeightedEdge(2, 3, 1)
+	g.AddWeightedEdge(3, 0, 4)
+	g.AddWeightedEdge(3, 0, 6)
+	c := NewCSRGraph(g)
+
+	distances, nextHops, hasNegativeCycle := FloydWarshall(c)
+	wantDistances, wantNextHops, _ := g.FloydWarshall()
+	assert.False(t, hasNegativeCycle)
+	assert.Equal(t, wantDistances, distances)
+	assert.Equal(t, wantNextHops, nextHops)
+
+	component, count := StronglyConnectedComponents(c)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []int{0, 0, 0, 0}, component)
+
+	fromEdges, err := NewCSRGraphFromEdges(3, []Edge{{From: 2, To: 0, Weight: 1}, {From: 0, To: 1, Weight: 1}})
+	assert.Nil(t, err)
+	order, hasCycle := TopoSort(fromEdges)
+	assert.False(t, hasCycle)
+	assert.Equal(t, []int{2, 0, 1}, order)
+
+	// undirected algorithms work on a CSR copy of an undirected graph
+	u := NewUndirectedGraph(4)
+	u.AddWeightedEdge(0, 1, 1)
+	u.AddWeightedEdge(1, 2, 2)
+	u.AddWeightedEdge(0, 2, 3)
+	edges, weight := Kruskal(NewCSRGraph(u))
+	assert.Equal(t, 3.0, weight)
+	assert.Len(t, edges, 2)
+	_, count = ConnectedComponents(NewCSRGraph(u))
+	assert.Equal(t, 2, count)
+}
@@ -0,0 +1,70 @@
+/*
+disjointset.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements Disjoint Set (aka Union-Find)
+
+package adt
+
+// DisjointSet keeps track of a partition of the elements 0..n-1 into disjoint sets
+// Implemented as a forest with union by rank & path compression, which makes the operations nearly O(1) (amortized).
+type DisjointSet struct {
+	parent []int
+	rank   []int
+	count  int
+}
+
+// NewDisjointSet creates & returns a DisjointSet of n elements, each one in its own set
+func NewDisjointSet(n int) *DisjointSet {
+	s := &DisjointSet{parent: make([]int, n), rank: make([]int, n), count: n}
+	for x := range s.parent {
+		s.parent[x] = x
+	}
+	return s
+}
+
+// Find returns the representative (root) element of the set the given element belongs to
+func (s *DisjointSet) Find(x int) int {
+	// find the root
+	root := x
+	for s.parent[root] != root {
+		root = s.parent[root]
+	}
+	// compress the path i.e. point all the elements on the path directly to the root
+	for s.parent[x] != root {
+		s.parent[x], x = root, s.parent[x]
+	}
+	return root
+}
+
+// Union merges the sets of the given elements
+// It returns false if they are in the same set already.
+func (s *DisjointSet) Union(x int, y int) bool {
+	rootX, rootY := s.Find(x), s.Find(y)
+	if rootX == rootY {
+		return false
+	}
+	// attach the shorter tree under the taller one
+	if s.rank[rootX] < s.rank[rootY] {
+		rootX, rootY = rootY, rootX
+	}
+	s.parent[rootY] = rootX
+	if s.rank[rootX] == s.rank[rootY] {
+		s.rank[rootX]++
+	}
+	s.count--
+	return true
+}
+
+// Connected tells whether the given elements are in the same set
+func (s *DisjointSet) Connected(x int, y int) bool {
+	return s.Find(x) == s.Find(y)
+}
+
+// Count returns the number of disjoint sets
+func (s *DisjointSet) Count() int {
+	return s.count
+}
@@ -0,0 +1,33 @@
+/*
+disjointset_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisjointSet(t *testing.T) {
+	s := NewDisjointSet(5)
+	assert.Equal(t, 5, s.Count())
+	assert.False(t, s.Connected(0, 1))
+
+	assert.True(t, s.Union(0, 1))
+	assert.True(t, s.Union(3, 4))
+	assert.Equal(t, 3, s.Count())
+	assert.True(t, s.Connected(1, 0))
+	assert.False(t, s.Connected(1, 3))
+
+	assert.True(t, s.Union(1, 4))
+	assert.False(t, s.Union(0, 3))
+	assert.Equal(t, 2, s.Count())
+	assert.True(t, s.Connected(0, 3))
+	assert.Equal(t, s.Find(0), s.Find(4))
+	assert.Equal(t, 2, s.Find(2))
+}
@@ -0,0 +1,172 @@
+/*
+dominators.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements the Dominator Trees of flow graphs (e.g. control flow graphs)
+
+package adt
+
+// DominatorTree denotes the dominator (or post-dominator) tree of a flow graph, rooted at its entry (or exit) vertex
+// The vertex d dominates v, if every path from the entry to v passes through d (every vertex dominates itself);
+// and post-dominates v, if every path from v to the exit passes through d.
+type DominatorTree struct {
+	// Root is the entry vertex (or the exit vertex, of a post-dominator tree)
+	Root int
+	// Idom holds the immediate dominator of each vertex i.e. its closest strict dominator, which is its parent in the tree
+	// It is -1 for the root & the vertices not reachable from the root.
+	Idom []int
+	// Frontiers holds the dominance frontier of each vertex (in ascending order) i.e. the vertices where its dominance
+	// ends: v is in the frontier of d, if d dominates a predecessor of v but does not strictly dominate v.
+	// e.g. the frontiers tell where the phi functions go, when converting to the SSA form.
+	Frontiers [][]int
+}
+
+// Dominators computes the dominator tree of the flow graph from the entry vertex, using Cooper-Harvey-Kennedy algo
+// i.e. the iterative data-flow algo, intersecting the dominators of the predecessors in reverse postorder.
+// Time Complexity: O(V + E) per iteration, taking a few iterations in practice (O(V^2) in the worst case)
+func Dominators(g GraphInterface, entry int) (*DominatorTree, error) {
+	if entry < 0 || entry >= g.NumVertices() {
+		return nil, ERR_VERTEX_OUT_OF_RANGE
+	}
+	return dominators(g, Transpose(g), entry), nil
+}
+
+// Dominators is a shorthand for Dominators(g, entry)
+func (g *Graph) Dominators(entry int) (*DominatorTree, error) {
+	return Dominators(g, entry)
+}
+
+// PostDominators computes the post-dominator tree of the flow graph to the exit vertex
+// i.e. the dominator tree of the transpose of the graph, from the exit vertex.
+// In case of many exits (e.g. return statements), add a virtual exit vertex having an edge from each of them.
+// Time Complexity: O(V + E) per iteration, taking a few iterations in practice (O(V^2) in the worst case)
+func PostDominators(g GraphInterface, exit int) (*DominatorTree, error) {
+	if exit < 0 || exit >= g.NumVertices() {
+		return nil, ERR_VERTEX_OUT_OF_RANGE
+	}
+	return dominators(Transpose(g), g, exit), nil
+}
+
+// PostDominators is a shorthand for PostDominators(g, exit)
+func (g *Graph) PostDominators(exit int) (*DominatorTree, error) {
+	return PostDominators(g, exit)
+}
+
+// dominators (private func) runs Cooper-Harvey-Kennedy algo on the graph, given its transpose (i.e. the predecessors)
+func dominators(g GraphInterface, transpose GraphInterface, root int) *DominatorTree {
+	n := g.NumVertices()
+
+	// number the vertices reachable from the root in postorder (-1 for the unreachable ones)
+	postorder := make([]int, n)
+	for vertex := range postorder {
+		postorder[vertex] = -1
+	}
+	order := []int{}
+	// the root is in range, so there is no error
+	DFSVisit(g, root, &Visitor{FinishVertex: func(vertex int) bool {
+		postorder[vertex] = len(order)
+		order = append(order, vertex)
+		return true
+	}})
+
+	idom := make([]int, n)
+	for vertex := range idom {
+		idom[vertex] = -1
+	}
+	idom[root] = root
+
+	// intersect walks up the (partial) tree from both the vertices till they meet, i.e. their nearest common dominator
+	intersect := func(a int, b int) int {
+		for a != b {
+			for postorder[a] < postorder[b] {
+				a = idom[a]
+			}
+			for postorder[b] < postorder[a] {
+				b = idom[b]
+			}
+		}
+		return a
+	}
+
+	for changed := true; changed; {
+		changed = false
+		// iterate the vertices in reverse postorder (except the root), so the predecessors mostly come first
+		for idx := len(order) - 2; idx >= 0; idx-- {
+			vertex := order[idx]
+			newIdom := -1
+			for _, pred := range transpose.Neighbors(vertex) {
+				// skip the predecessors not yet processed, and the unreachable ones
+				if idom[pred] == -1 {
+					continue
+				}
+				if newIdom == -1 {
+					newIdom = pred
+				} else {
+					newIdom = intersect(pred, newIdom)
+				}
+			}
+			if idom[vertex] != newIdom {
+				idom[vertex] = newIdom
+				changed = true
+			}
+		}
+	}
+	// the root has no (strict) dominator
+	idom[root] = -1
+
+	// walk up from each predecessor of a vertex till its immediate dominator, the vertex is in the frontier of all on the way
+	frontiers := make([][]int, n)
+	for vertex := range frontiers {
+		frontiers[vertex] = []int{}
+	}
+	for vertex := 0; vertex < n; vertex++ {
+		if postorder[vertex] == -1 {
+			continue
+		}
+		for _, pred := range transpose.Neighbors(vertex) {
+			if postorder[pred] == -1 {
+				continue
+			}
+			for runner := pred; runner != idom[vertex]; runner = idom[runner] {
+				// a runner may be reached via many predecessors, so add the vertex once
+				// (the vertices are taken in ascending order, so the frontiers remain sorted)
+				if f := frontiers[runner]; len(f) == 0 || f[len(f)-1] != vertex {
+					frontiers[runner] = append(f, vertex)
+				}
+				if runner == root {
+					break
+				}
+			}
+		}
+	}
+	return &DominatorTree{Root: root, Idom: idom, Frontiers: frontiers}
+}
+
+// Dominates tells whether the vertex d dominates the vertex v (every vertex dominates itself)
+// Time Complexity: O(depth of v in the tree)
+func (t *DominatorTree) Dominates(d int, v int) bool {
+	if v != t.Root && t.Idom[v] == -1 {
+		// v is not reachable from the root
+		return false
+	}
+	for ; v != -1; v = t.Idom[v] {
+		if v == d {
+			return true
+		}
+	}
+	return false
+}
+
+// Children returns the vertices immediately dominated by the vertex i.e. its children in the tree, in ascending order
+func (t *DominatorTree) Children(vertex int) []int {
+	children := []int{}
+	for v, idom := range t.Idom {
+		if idom == vertex {
+			children = append(children, v)
+		}
+	}
+	return children
+}
@@ -0,0 +1,80 @@
+/*
+dominators_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newFlowGraph returns a control flow graph having an if/else diamond inside a loop, and an unreachable vertex 7
+//
+//	0 -> 1 -> 2 -> 4 -> 5 -> 6
+//	     ^ \-> 3 -/    |
+//	     \-------------/
+func newFlowGraph() *Graph {
+	g := NewGraph(8)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 4)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 1)
+	g.AddEdge(5, 6)
+	g.AddEdge(7, 4)
+	return g
+}
+
+func TestGraph_Dominators(t *testing.T) {
+	g := newFlowGraph()
+	tree, err := g.Dominators(0)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, tree.Root)
+	assert.Equal(t, []int{-1, 0, 1, 1, 1, 4, 5, -1}, tree.Idom)
+	assert.Equal(t, [][]int{{}, {1}, {4}, {4}, {1}, {1}, {}, {}}, tree.Frontiers)
+	assert.Equal(t, []int{2, 3, 4}, tree.Children(1))
+
+	assert.True(t, tree.Dominates(0, 6))
+	assert.True(t, tree.Dominates(1, 5))
+	assert.True(t, tree.Dominates(4, 4))
+	assert.False(t, tree.Dominates(2, 4))
+	assert.False(t, tree.Dominates(5, 1))
+	assert.False(t, tree.Dominates(0, 7))
+	assert.False(t, tree.Dominates(7, 4))
+
+	_, err = g.Dominators(8)
+	assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err)
+}
+
+func TestGraph_DominatorsOfLoopAtEntry(t *testing.T) {
+	g := NewGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 0)
+	g.AddEdge(1, 2)
+
+	tree, err := g.Dominators(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{-1, 0, 1}, tree.Idom)
+	assert.Equal(t, [][]int{{0}, {0}, {}}, tree.Frontiers)
+}
+
+func TestGraph_PostDominators(t *testing.T) {
+	g := newFlowGraph()
+	tree, err := g.PostDominators(6)
+	assert.Nil(t, err)
+	assert.Equal(t, 6, tree.Root)
+	assert.Equal(t, []int{1, 4, 4, 4, 5, 6, -1, 4}, tree.Idom)
+	assert.Equal(t, [][]int{{}, {5}, {1}, {1}, {5}, {5}, {}, {}}, tree.Frontiers)
+	assert.True(t, tree.Dominates(4, 2))
+	assert.False(t, tree.Dominates(2, 1))
+
+	_, err = PostDominators(g, -1)
+	assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err)
+}
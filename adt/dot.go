@@ -0,0 +1,501 @@
+/*
+dot.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements the export & import of Graphs in the Graphviz DOT format
+
+package adt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	myerr "github.com/toransahu/goutils/errors"
+)
+
+var ERR_INVALID_DOT myerr.UserDefinedError = "invalid DOT"
+
+// DOTOptions customizes the DOT written by WriteDOT
+type DOTOptions struct {
+	// Name is the name of the graph; defaults to "G"
+	Name string
+	// Labels holds the label of the vertices, the vertices without a label are labelled by their number
+	Labels map[int]string
+	// Weights labels every edge with its weight
+	Weights bool
+	// Highlight is a path (e.g. the output of TopoSort or PathTo) whose vertices & edges are highlighted
+	Highlight []int
+	// Cycle tells that Highlight is a cycle (e.g. the output of FindCycle), so its last edge back to the first vertex is highlighted too
+	Cycle bool
+	// Undirected writes an undirected graph, which stores every edge u - v as u -> v & v -> u (as UndirectedGraph does)
+	Undirected bool
+}
+
+// WriteDOT writes the graph in the Graphviz DOT format
+// e.g. `dot -Tsvg` renders the output into an image.
+// The vertex & edge attributes of an AttributedGraphInterface (e.g. Graph) are written as the DOT attributes,
+// unless overridden by the options (e.g. the label of a vertex in Labels).
+func WriteDOT(w io.Writer, g GraphInterface, opts *DOTOptions) error {
+	if opts == nil {
+		opts = &DOTOptions{}
+	}
+	name := opts.Name
+	if name == "" {
+		name = "G"
+	}
+	kind, op := "digraph", "->"
+	if opts.Undirected {
+		kind, op = "graph", "--"
+	}
+
+	// to flag the highlighted vertices & edges
+	highlightedVertices := map[int]bool{}
+	highlightedEdges := map[[2]int]bool{}
+	for idx, vertex := range opts.Highlight {
+		highlightedVertices[vertex] = true
+		next := idx + 1
+		if next == len(opts.Highlight) {
+			if !opts.Cycle {
+				break
+			}
+			next = 0
+		}
+		edge := [2]int{vertex, opts.Highlight[next]}
+		if opts.Undirected && edge[0] > edge[1] {
+			edge = [2]int{edge[1], edge[0]}
+		}
+		highlightedEdges[edge] = true
+	}
+
+	vertexAttributes, edgeAttributes := attributesOf(g)
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%s %s {\n", kind, dotQuote(name))
+	// list all the vertices, so the isolated ones are kept as well
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		attrs := []string{}
+		if label, found := opts.Labels[vertex]; found {
+			attrs = append(attrs, "label="+dotQuote(label))
+		}
+		if highlightedVertices[vertex] {
+			attrs = append(attrs, "color=red", "fontcolor=red")
+		}
+		attrs = append(dotAttributes(vertexAttributes[vertex], attrs), attrs...)
+		fmt.Fprintf(bw, "\t%d%s;\n", vertex, dotAttrs(attrs))
+	}
+	for u := 0; u < g.NumVertices(); u++ {
+		neighbors, weights := g.Neighbors(u), g.EdgeWeights(u)
+		for idx, v := range neighbors {
+			// every edge u - v is stored as u -> v & v -> u, so pick only one of them
+			if opts.Undirected && u > v {
+				continue
+			}
+			attrs := []string{}
+			if opts.Weights {
+				attrs = append(attrs, "label="+dotQuote(strconv.FormatFloat(weights[idx], 'g', -1, 64)))
+			}
+			if highlightedEdges[[2]int{u, v}] {
+				attrs = append(attrs, "color=red", "penwidth=2")
+			}
+			attrs = append(dotAttributes(edgeAttributes[[2]int{u, v}], attrs), attrs...)
+			fmt.Fprintf(bw, "\t%d %s %d%s;\n", u, op, v, dotAttrs(attrs))
+		}
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// WriteDOT is a shorthand for WriteDOT(w, g, opts)
+func (g *Graph) WriteDOT(w io.Writer, opts *DOTOptions) error {
+	return WriteDOT(w, g, opts)
+}
+
+// dotQuote (private func) quotes the string as a DOT ID
+func dotQuote(s string) string {
+	return `"` + strings.Replace(s, `"`, `\"`, -1) + `"`
+}
+
+// dotAttributes (private func) formats the attributes of a vertex or an edge as DOT attributes (in the order of
+// their keys), except the ones overridden i.e. having the same key as one of the formatted attributes given
+func dotAttributes(attributes Attributes, overridden []string) []string {
+	keys := map[string]bool{}
+	for _, attr := range overridden {
+		keys[attr[:strings.Index(attr, "=")]] = true
+	}
+	attrs := []string{}
+	for _, key := range sortedKeys(attributes) {
+		if keys[key] {
+			continue
+		}
+		id := key
+		// quote the key, unless it is a plain ID
+		for idx := 0; idx < len(key); idx++ {
+			if !isDOTIDByte(key[idx]) {
+				id = dotQuote(key)
+				break
+			}
+		}
+		attrs = append(attrs, id+"="+dotQuote(attributes[key]))
+	}
+	return attrs
+}
+
+// dotAttrs (private func) formats the attributes as a DOT attribute list
+func dotAttrs(attrs []string) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+	return " [" + strings.Join(attrs, ", ") + "]"
+}
+
+// ParseDOT builds a Graph from a DOT subset
+// It returns the graph and the DOT ID of each vertex, as the vertices are numbered in the order they appear.
+// Supported are a `graph` or `digraph` (optionally `strict`), with the node statements, the edge statements
+// (including chains like a -> b -> c), attribute lists & comments. Subgraphs, ports & HTML strings are not.
+// The weight of an edge is its `weight` attribute, or else its `label` if numeric, or else 1.
+// The attributes of a node statement (e.g. the `label` written by WriteDOT) are set as the vertex attributes,
+// and the ones of an edge statement as the edge attributes (shared by the parallel edges, as in Graph);
+// the default attributes (e.g. node [shape=box]) are ignored.
+// An undirected edge u -- v is stored as u -> v & v -> u (as UndirectedGraph does).
+func ParseDOT(r io.Reader) (*Graph, []string, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	tokens, err := dotTokenize(string(src))
+	if err != nil {
+		return nil, nil, err
+	}
+	p := &dotParser{tokens: tokens, graph: NewGraph(0), ids: map[string]int{}, names: []string{}}
+	if err := p.parse(); err != nil {
+		return nil, nil, err
+	}
+	return p.graph, p.names, nil
+}
+
+// dotToken is a token of the DOT language
+type dotToken struct {
+	text string
+	// whether the token is an ID (as opposed to an operator or a punctuation)
+	isID bool
+	line int
+}
+
+// dotTokenize (private func) splits the DOT source into tokens, dropping the comments
+func dotTokenize(src string) ([]dotToken, error) {
+	tokens := []dotToken{}
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == '#' || strings.HasPrefix(src[i:], "//"):
+			// a line comment
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			// a block comment
+			end := strings.Index(src[i+2:], "*/")
+			if end == -1 {
+				return nil, dotError(line, "unterminated comment")
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case strings.HasPrefix(src[i:], "->") || strings.HasPrefix(src[i:], "--"):
+			tokens = append(tokens, dotToken{text: src[i : i+2], line: line})
+			i += 2
+		case strings.IndexByte("{}[];,=", c) != -1:
+			tokens = append(tokens, dotToken{text: string(c), line: line})
+			i++
+		case c == '"':
+			// a quoted string, where \" is an escaped quote
+			start := line
+			var sb strings.Builder
+			i++
+			for ; i < len(src) && src[i] != '"'; i++ {
+				if src[i] == '\\' && i+1 < len(src) && src[i+1] == '"' {
+					i++
+				} else if src[i] == '\n' {
+					line++
+				}
+				sb.WriteByte(src[i])
+			}
+			if i == len(src) {
+				return nil, dotError(start, "unterminated string")
+			}
+			i++
+			tokens = append(tokens, dotToken{text: sb.String(), isID: true, line: start})
+		case isDOTIDByte(c) || c == '-' || c == '.':
+			// an alphanumeric ID or a numeral
+			j := i + 1
+			for j < len(src) && (isDOTIDByte(src[j]) || src[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, dotToken{text: src[i:j], isID: true, line: line})
+			i = j
+		default:
+			return nil, dotError(line, fmt.Sprintf("unexpected %q", c))
+		}
+	}
+	return tokens, nil
+}
+
+// isDOTIDByte (private func) tells whether the byte can be a part of an unquoted DOT ID
+func isDOTIDByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
+
+// dotError (private func) returns an error wrapping ERR_INVALID_DOT
+func dotError(line int, msg string) error {
+	return fmt.Errorf("%w: line %d: %s", ERR_INVALID_DOT, line, msg)
+}
+
+// dotParser holds the state of parsing the DOT tokens into a Graph
+type dotParser struct {
+	tokens []dotToken
+	pos    int
+	graph  *Graph
+	// the vertex of each DOT ID
+	ids map[string]int
+	// the DOT ID of each vertex
+	names    []string
+	directed bool
+}
+
+// peek (private func) returns the current token, or an empty one at the end
+func (p *dotParser) peek() dotToken {
+	if p.pos == len(p.tokens) {
+		line := 0
+		if len(p.tokens) > 0 {
+			line = p.tokens[len(p.tokens)-1].line
+		}
+		return dotToken{line: line}
+	}
+	return p.tokens[p.pos]
+}
+
+// next (private func) returns the current token & moves to the next one
+func (p *dotParser) next() dotToken {
+	t := p.peek()
+	if p.pos < len(p.tokens) {
+		p.pos++
+	}
+	return t
+}
+
+// expect (private func) consumes the current token, which must be the given punctuation
+func (p *dotParser) expect(text string) error {
+	if t := p.next(); t.isID || t.text != text {
+		return dotError(t.line, fmt.Sprintf("expected %q, got %q", text, t.text))
+	}
+	return nil
+}
+
+// id (private func) consumes the current token, which must be an ID
+func (p *dotParser) id() (dotToken, error) {
+	t := p.next()
+	if !t.isID {
+		return t, dotError(t.line, fmt.Sprintf("expected an ID, got %q", t.text))
+	}
+	return t, nil
+}
+
+// parse (private func) parses the whole graph
+func (p *dotParser) parse() error {
+	t, err := p.id()
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(t.text, "strict") {
+		if t, err = p.id(); err != nil {
+			return err
+		}
+	}
+	switch strings.ToLower(t.text) {
+	case "digraph":
+		p.directed = true
+	case "graph":
+		p.directed = false
+	default:
+		return dotError(t.line, fmt.Sprintf("expected graph or digraph, got %q", t.text))
+	}
+	// the optional name of the graph
+	if p.peek().isID {
+		p.next()
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for {
+		t := p.peek()
+		if !t.isID && t.text == "}" {
+			p.next()
+			break
+		}
+		if err := p.statement(); err != nil {
+			return err
+		}
+	}
+	if t := p.peek(); p.pos != len(p.tokens) {
+		return dotError(t.line, fmt.Sprintf("unexpected %q after the graph", t.text))
+	}
+	return nil
+}
+
+// statement (private func) parses a statement of the graph body
+func (p *dotParser) statement() error {
+	t := p.peek()
+	if !t.isID {
+		switch t.text {
+		case ";":
+			p.next()
+			return nil
+		case "{":
+			return dotError(t.line, "subgraphs are not supported")
+		case "":
+			return dotError(t.line, "unexpected end of the graph")
+		}
+		return dotError(t.line, fmt.Sprintf("unexpected %q", t.text))
+	}
+	p.next()
+
+	switch strings.ToLower(t.text) {
+	case "graph", "node", "edge":
+		// the default attributes, which are ignored
+		if next := p.peek(); !next.isID && next.text == "[" {
+			_, err := p.attrs()
+			return err
+		}
+	case "subgraph":
+		return dotError(t.line, "subgraphs are not supported")
+	}
+
+	// a graph attribute e.g. rankdir=LR, which is ignored
+	if next := p.peek(); !next.isID && next.text == "=" {
+		p.next()
+		_, err := p.id()
+		return err
+	}
+
+	// a node statement, or an edge statement having a chain of nodes
+	chain := []int{p.vertex(t.text)}
+	for {
+		op := p.peek()
+		if op.isID || (op.text != "->" && op.text != "--") {
+			break
+		}
+		if p.directed != (op.text == "->") {
+			return dotError(op.line, fmt.Sprintf("unexpected %q, as -> is for digraph & -- is for graph", op.text))
+		}
+		p.next()
+		head, err := p.id()
+		if err != nil {
+			return err
+		}
+		chain = append(chain, p.vertex(head.text))
+	}
+
+	attrs := map[string]string{}
+	if next := p.peek(); !next.isID && next.text == "[" {
+		var err error
+		if attrs, err = p.attrs(); err != nil {
+			return err
+		}
+	}
+	// a node statement: keep its attributes (e.g. the label) as the vertex attributes
+	if len(chain) == 1 {
+		for name, value := range attrs {
+			p.graph.SetVertexAttribute(chain[0], name, value)
+		}
+		return nil
+	}
+
+	weight, err := dotWeight(attrs, t.line)
+	if err != nil {
+		return err
+	}
+	for idx := 1; idx < len(chain); idx++ {
+		u, v := chain[idx-1], chain[idx]
+		p.graph.AddWeightedEdge(u, v, weight)
+		if !p.directed && u != v {
+			p.graph.AddWeightedEdge(v, u, weight)
+		}
+		for name, value := range attrs {
+			p.graph.SetEdgeAttribute(u, v, name, value)
+			if !p.directed {
+				p.graph.SetEdgeAttribute(v, u, name, value)
+			}
+		}
+	}
+	return nil
+}
+
+// attrs (private func) parses one or more attribute lists e.g. [label="a", weight=2][color=red]
+func (p *dotParser) attrs() (map[string]string, error) {
+	attrs := map[string]string{}
+	for {
+		if t := p.peek(); t.isID || t.text != "[" {
+			return attrs, nil
+		}
+		p.next()
+		for {
+			t := p.next()
+			if !t.isID && t.text == "]" {
+				break
+			}
+			if !t.isID {
+				return nil, dotError(t.line, fmt.Sprintf("expected an attribute, got %q", t.text))
+			}
+			value := "true"
+			if next := p.peek(); !next.isID && next.text == "=" {
+				p.next()
+				v, err := p.id()
+				if err != nil {
+					return nil, err
+				}
+				value = v.text
+			}
+			attrs[t.text] = value
+			// the optional separator
+			if next := p.peek(); !next.isID && (next.text == "," || next.text == ";") {
+				p.next()
+			}
+		}
+	}
+}
+
+// vertex (private func) returns the vertex of the DOT ID, adding a new vertex if not present already
+func (p *dotParser) vertex(name string) int {
+	if id, found := p.ids[name]; found {
+		return id
+	}
+	id := p.graph.AddVertex()
+	p.ids[name] = id
+	p.names = append(p.names, name)
+	return id
+}
+
+// dotWeight (private func) returns the weight of an edge given its attributes
+func dotWeight(attrs map[string]string, line int) (float64, error) {
+	if value, found := attrs["weight"]; found {
+		weight, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, dotError(line, fmt.Sprintf("invalid weight %q", value))
+		}
+		return weight, nil
+	}
+	if weight, err := strconv.ParseFloat(attrs["label"], 64); err == nil {
+		return weight, nil
+	}
+	return 1, nil
+}
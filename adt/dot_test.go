@@ -0,0 +1,195 @@
+/*
+dot_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteDOT(t *testing.T) {
+	g := NewGraph(4)
+	g.AddWeightedEdge(0, 1, 2.5)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+
+	var buf bytes.Buffer
+	assert.Nil(t, g.WriteDOT(&buf, nil))
+	assert.Equal(t, `digraph "G" {
+	0;
+	1;
+	2;
+	3;
+	0 -> 1;
+	1 -> 2;
+	2 -> 0;
+}
+`, buf.String())
+
+	// highlight the cycle
+	cycle, _ := g.FindCycle()
+	buf.Reset()
+	err := WriteDOT(&buf, g, &DOTOptions{
+		Name:      "deps",
+		Labels:    map[int]string{0: "app", 3: `say "hi"`},
+		Weights:   true,
+		Highlight: cycle,
+		Cycle:     true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `digraph "deps" {
+	0 [label="app", color=red, fontcolor=red];
+	1 [color=red, fontcolor=red];
+	2 [color=red, fontcolor=red];
+	3 [label="say \"hi\""];
+	0 -> 1 [label="2.5", color=red, penwidth=2];
+	1 -> 2 [label="1", color=red, penwidth=2];
+	2 -> 0 [label="1", color=red, penwidth=2];
+}
+`, buf.String())
+
+	// highlight a path of an undirected graph
+	u := NewUndirectedGraph(3)
+	u.AddEdge(0, 1)
+	u.AddEdge(1, 2)
+	buf.Reset()
+	assert.Nil(t, WriteDOT(&buf, u, &DOTOptions{Undirected: true, Highlight: []int{2, 1}}))
+	assert.Equal(t, `graph "G" {
+	0;
+	1 [color=red, fontcolor=red];
+	2 [color=red, fontcolor=red];
+	0 -- 1;
+	1 -- 2 [color=red, penwidth=2];
+}
+`, buf.String())
+}
+
+func TestParseDOT(t *testing.T) {
+	src := `
+	/* a dependency graph */
+	strict digraph deps {
+		rankdir=LR; // left to right
+		node [shape=box]
+		app [label="App"];
+		app -> lib -> "core lib" [weight=2]
+		app -> "core lib" [label="0.5"]
+		# an isolated vertex
+		tool
+	}`
+	g, names, err := ParseDOT(strings.NewReader(src))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"app", "lib", "core lib", "tool"}, names)
+	assert.Equal(t, [][]int{{1, 2}, {2}, {}, {}}, g.AdjacencyList)
+	assert.Equal(t, [][]float64{{2, 0.5}, {2}, {}, {}}, g.Weights)
+	assert.Equal(t, map[int]Attributes{0: {"label": "App"}}, g.VertexAttributes)
+
+	// undirected
+	g, names, err = ParseDOT(strings.NewReader(`graph { a -- b; b -- b }`))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, names)
+	assert.Equal(t, [][]int{{1}, {0, 1}}, g.AdjacencyList)
+
+	// round trip
+	var buf bytes.Buffer
+	want := NewGraph(3)
+	want.AddWeightedEdge(0, 2, -1.5)
+	want.AddEdge(2, 1)
+	assert.Nil(t, WriteDOT(&buf, want, &DOTOptions{Weights: true}))
+	g, _, err = ParseDOT(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, want.AdjacencyList, g.AdjacencyList)
+	assert.Equal(t, want.Weights, g.Weights)
+	// the weights are written as the labels, which are kept as the edge attributes
+	assert.Equal(t, map[[2]int]Attributes{{0, 2}: {"label": "-1.5"}, {2, 1}: {"label": "1"}}, g.EdgeAttributes)
+
+	// round trip of the labels, and a node having a weight attribute
+	buf.Reset()
+	assert.Nil(t, WriteDOT(&buf, want, &DOTOptions{Labels: map[int]string{0: "app", 2: `say "hi"`}}))
+	g, _, err = ParseDOT(&buf)
+	assert.Nil(t, err)
+	label, _ := g.VertexAttribute(2, "label")
+	assert.Equal(t, `say "hi"`, label)
+	assert.Equal(t, map[int]Attributes{0: {"label": "app"}, 2: {"label": `say "hi"`}}, g.VertexAttributes)
+
+	// round trip of the vertex & edge attributes: parse -> write -> parse
+	src = `digraph {
+		app [label="App", shape=box]
+		app -> lib -> core [weight=2, color=red]
+		lib -> core [style=dashed]
+		app -> core [label="0.5"]
+	}`
+	want, _, err = ParseDOT(strings.NewReader(src))
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]Attributes{0: {"label": "App", "shape": "box"}}, want.VertexAttributes)
+	assert.Equal(t, map[[2]int]Attributes{
+		{0, 1}: {"weight": "2", "color": "red"},
+		// shared by the parallel edges
+		{1, 2}: {"weight": "2", "color": "red", "style": "dashed"},
+		{0, 2}: {"label": "0.5"},
+	}, want.EdgeAttributes)
+	buf.Reset()
+	assert.Nil(t, want.WriteDOT(&buf, nil))
+	assert.Equal(t, `digraph "G" {
+	0 [label="App", shape="box"];
+	1;
+	2;
+	0 -> 1 [color="red", weight="2"];
+	0 -> 2 [label="0.5"];
+	1 -> 2 [color="red", style="dashed", weight="2"];
+	1 -> 2 [color="red", style="dashed", weight="2"];
+}
+`, buf.String())
+	g, _, err = ParseDOT(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int{{1, 2}, {2, 2}, {}}, g.AdjacencyList)
+	assert.Equal(t, [][]float64{{2, 0.5}, {2, 2}, {}}, g.Weights)
+	assert.Equal(t, want.VertexAttributes, g.VertexAttributes)
+	assert.Equal(t, want.EdgeAttributes, g.EdgeAttributes)
+
+	// the options override the attributes of the same key, and an undirected edge keeps its attributes
+	u := NewUndirectedGraph(2)
+	u.AddEdge(0, 1)
+	u.SetVertexAttribute(0, "label", "a")
+	u.SetEdgeAttribute(0, 1, "my key", "x")
+	buf.Reset()
+	assert.Nil(t, WriteDOT(&buf, u, &DOTOptions{Undirected: true, Labels: map[int]string{0: "b"}}))
+	assert.Equal(t, `graph "G" {
+	0 [label="b"];
+	1;
+	0 -- 1 ["my key"="x"];
+}
+`, buf.String())
+	g, _, err = ParseDOT(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, map[[2]int]Attributes{{0, 1}: {"my key": "x"}, {1, 0}: {"my key": "x"}}, g.EdgeAttributes)
+
+	g, _, err = ParseDOT(strings.NewReader(`digraph { a [weight=x]; a -> b }`))
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]Attributes{0: {"weight": "x"}}, g.VertexAttributes)
+	assert.Equal(t, [][]float64{{1}, {}}, g.Weights)
+
+	for _, src := range []string{
+		`digraph { a -- b }`,
+		`graph { a -> b }`,
+		`digraph { subgraph s { a } }`,
+		`digraph { a -> }`,
+		`digraph { a [weight=heavy] -> b }`,
+		`digraph { a -> b [weight=heavy] }`,
+		`digraph { "a }`,
+		`tree { a }`,
+		`digraph { a } b`,
+		`digraph { a`,
+	} {
+		_, _, err := ParseDOT(strings.NewReader(src))
+		assert.True(t, errors.Is(err, ERR_INVALID_DOT), src)
+	}
+}
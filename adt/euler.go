@@ -0,0 +1,252 @@
+/*
+euler.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements the Eulerian & Hamiltonian paths of Graphs
+
+package adt
+
+import (
+	myerr "github.com/toransahu/goutils/errors"
+)
+
+var ERR_GRAPH_TOO_LARGE myerr.UserDefinedError = "graph is too large"
+
+// EulerianPath finds a path of the directed graph traversing every edge exactly once, using Hierholzer's algo
+// It exists if all the edges are connected, and all the vertices have in-degree == out-degree, except
+// the start (out-degree == in-degree + 1) & the end (in-degree == out-degree + 1), if any.
+// It returns the vertices of the path (E+1 of them, empty if there are no edges), and whether such a path exists.
+// Time Complexity: O(V + E)
+func EulerianPath(g GraphInterface) ([]int, bool) {
+	return eulerian(g, false, false)
+}
+
+// EulerianPath is a shorthand for EulerianPath(g)
+func (g *Graph) EulerianPath() ([]int, bool) {
+	return EulerianPath(g)
+}
+
+// EulerianCircuit finds a cycle of the directed graph traversing every edge exactly once, using Hierholzer's algo
+// It exists if all the edges are connected, and all the vertices have in-degree == out-degree.
+// It returns the vertices of the circuit (E+1 of them, with the first one repeated at the end; empty if there are no edges),
+// and whether such a circuit exists.
+// Time Complexity: O(V + E)
+func EulerianCircuit(g GraphInterface) ([]int, bool) {
+	return eulerian(g, false, true)
+}
+
+// EulerianCircuit is a shorthand for EulerianCircuit(g)
+func (g *Graph) EulerianCircuit() ([]int, bool) {
+	return EulerianCircuit(g)
+}
+
+// EulerianPathUndirected finds a path of the undirected graph traversing every edge exactly once, using Hierholzer's algo
+// It exists if all the edges are connected, and none or two vertices have an odd degree (the ends of the path).
+// The graph is expected to store every edge u - v as u -> v & v -> u, as UndirectedGraph does.
+// Time Complexity: O(V + E)
+func EulerianPathUndirected(g GraphInterface) ([]int, bool) {
+	return eulerian(g, true, false)
+}
+
+// EulerianPath is a shorthand for EulerianPathUndirected(g)
+func (g *UndirectedGraph) EulerianPath() ([]int, bool) {
+	return EulerianPathUndirected(g)
+}
+
+// EulerianCircuitUndirected finds a cycle of the undirected graph traversing every edge exactly once, using Hierholzer's algo
+// It exists if all the edges are connected, and all the vertices have an even degree.
+// The graph is expected to store every edge u - v as u -> v & v -> u, as UndirectedGraph does.
+// Time Complexity: O(V + E)
+func EulerianCircuitUndirected(g GraphInterface) ([]int, bool) {
+	return eulerian(g, true, true)
+}
+
+// EulerianCircuit is a shorthand for EulerianCircuitUndirected(g)
+func (g *UndirectedGraph) EulerianCircuit() ([]int, bool) {
+	return EulerianCircuitUndirected(g)
+}
+
+// eulerian (private func) checks the degrees, picks the start vertex & runs Hierholzer's algo
+func eulerian(g GraphInterface, undirected bool, circuit bool) ([]int, bool) {
+	n := g.NumVertices()
+	// the number of edges, and the balance of each vertex i.e. out-degree - in-degree (or the degree, if undirected)
+	edges := 0
+	balance := make([]int, n)
+	for u := 0; u < n; u++ {
+		for _, v := range g.Neighbors(u) {
+			if !undirected {
+				edges++
+				balance[u]++
+				balance[v]--
+				continue
+			}
+			// every edge u - v is seen from both ends, except a self loop which is stored once but adds 2 to the degree
+			balance[u]++
+			if u == v {
+				balance[u]++
+				edges += 2
+			} else {
+				edges++
+			}
+		}
+	}
+	if undirected {
+		edges /= 2
+	}
+	if edges == 0 {
+		return []int{}, true
+	}
+
+	// the vertices which are not balanced i.e. having an odd degree, or out-degree != in-degree
+	start, unbalanced := -1, []int{}
+	for vertex := 0; vertex < n; vertex++ {
+		if (undirected && balance[vertex]%2 != 0) || (!undirected && balance[vertex] != 0) {
+			unbalanced = append(unbalanced, vertex)
+		} else if start == -1 && len(g.Neighbors(vertex)) > 0 {
+			// a circuit can start at any vertex having an edge
+			start = vertex
+		}
+	}
+	switch {
+	case len(unbalanced) == 0:
+	case len(unbalanced) == 2 && !circuit:
+		// a path starts at one of the odd vertices, or at the vertex having an extra outgoing edge
+		start = unbalanced[0]
+		if !undirected {
+			if balance[start] != 1 {
+				start = unbalanced[1]
+			}
+			if balance[start] != 1 || balance[unbalanced[0]]+balance[unbalanced[1]] != 0 {
+				return nil, false
+			}
+		}
+	default:
+		return nil, false
+	}
+
+	path := hierholzer(g, start, undirected)
+	// some of the edges were not reachable i.e. the edges are disconnected
+	if len(path) != edges+1 {
+		return nil, false
+	}
+	return path, true
+}
+
+// hierholzer (private func) runs Hierholzer's algo from the start vertex, and returns the traversed vertices
+// It keeps walking along the unused edges till getting stuck, then backtracks to the latest vertex having an unused edge
+// to splice in another closed walk from there.
+func hierholzer(g GraphInterface, start int, undirected bool) []int {
+	// the index of the next unused edge of each vertex
+	next := make([]int, g.NumVertices())
+	// the neighbors of each vertex, fetched once as Neighbors may take O(V) time (e.g. of an AdjacencyMatrix)
+	adjacency := make([][]int, g.NumVertices())
+	// the number of u -> v copies to skip, as their twin v -> u (of an undirected edge) was used already
+	skip := map[[2]int]int{}
+
+	path := []int{}
+	stack := []int{start}
+	for len(stack) > 0 {
+		vertex := stack[len(stack)-1]
+		if adjacency[vertex] == nil {
+			adjacency[vertex] = g.Neighbors(vertex)
+		}
+		neighbors := adjacency[vertex]
+		if next[vertex] == len(neighbors) {
+			// stuck, so the vertex is done
+			stack = stack[:len(stack)-1]
+			path = append(path, vertex)
+			continue
+		}
+		u := neighbors[next[vertex]]
+		next[vertex]++
+		if undirected {
+			edge := [2]int{vertex, u}
+			if skip[edge] > 0 {
+				skip[edge]--
+				continue
+			}
+			if u != vertex {
+				skip[[2]int{u, vertex}]++
+			}
+		}
+		stack = append(stack, u)
+	}
+
+	// the vertices are done in the reverse order
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// HamiltonianPath finds a path visiting every vertex exactly once, using backtracking
+// As the search takes exponential time, it fails with ERR_GRAPH_TOO_LARGE if the graph has more than maxVertices vertices.
+// It returns the vertices of the path, and whether such a path exists.
+// Time Complexity: O(V!) in the worst case
+func HamiltonianPath(g GraphInterface, maxVertices int) ([]int, bool, error) {
+	return hamiltonian(g, maxVertices, false)
+}
+
+// HamiltonianPath is a shorthand for HamiltonianPath(g, maxVertices)
+func (g *Graph) HamiltonianPath(maxVertices int) ([]int, bool, error) {
+	return HamiltonianPath(g, maxVertices)
+}
+
+// HamiltonianCycle finds a cycle visiting every vertex exactly once, using backtracking
+// As the search takes exponential time, it fails with ERR_GRAPH_TOO_LARGE if the graph has more than maxVertices vertices.
+// It returns the vertices of the cycle (without repeating the first one at the end), and whether such a cycle exists.
+// Time Complexity: O(V!) in the worst case
+func HamiltonianCycle(g GraphInterface, maxVertices int) ([]int, bool, error) {
+	return hamiltonian(g, maxVertices, true)
+}
+
+// HamiltonianCycle is a shorthand for HamiltonianCycle(g, maxVertices)
+func (g *Graph) HamiltonianCycle(maxVertices int) ([]int, bool, error) {
+	return HamiltonianCycle(g, maxVertices)
+}
+
+// hamiltonian (private func) runs the backtracking search of a Hamiltonian path or cycle
+func hamiltonian(g GraphInterface, maxVertices int, cycle bool) ([]int, bool, error) {
+	n := g.NumVertices()
+	if n > maxVertices {
+		return nil, false, ERR_GRAPH_TOO_LARGE
+	}
+	if n == 0 {
+		return []int{}, true, nil
+	}
+
+	path := make([]int, 0, n)
+	visited := make([]bool, n)
+	var extend func(vertex int) bool
+	extend = func(vertex int) bool {
+		visited[vertex] = true
+		path = append(path, vertex)
+		if len(path) == n && (!cycle || g.HasEdge(vertex, path[0])) {
+			return true
+		}
+		for _, u := range g.Neighbors(vertex) {
+			if !visited[u] && extend(u) {
+				return true
+			}
+		}
+		// backtrack
+		visited[vertex] = false
+		path = path[:len(path)-1]
+		return false
+	}
+
+	// a cycle passes through every vertex, so it is enough to start from one of them
+	starts := n
+	if cycle {
+		starts = 1
+	}
+	for start := 0; start < starts; start++ {
+		if extend(start) {
+			return path, true, nil
+		}
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,184 @@
+/*
+euler_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assertEulerian (private func) asserts that the path traverses every edge of the graph exactly once
+func assertEulerian(t *testing.T, g GraphInterface, undirected bool, path []int) {
+	t.Helper()
+	remaining := map[[2]int]int{}
+	for u := 0; u < g.NumVertices(); u++ {
+		for _, v := range g.Neighbors(u) {
+			if undirected && u > v {
+				continue
+			}
+			remaining[[2]int{u, v}]++
+		}
+	}
+	for idx := 1; idx < len(path); idx++ {
+		edge := [2]int{path[idx-1], path[idx]}
+		if undirected && edge[0] > edge[1] {
+			edge = [2]int{edge[1], edge[0]}
+		}
+		if !assert.True(t, remaining[edge] > 0, "edge %v of %v", edge, path) {
+			return
+		}
+		remaining[edge]--
+	}
+	for edge, count := range remaining {
+		assert.Equal(t, 0, count, "edge %v not traversed by %v", edge, path)
+	}
+}
+
+func TestGraph_EulerianPath(t *testing.T) {
+	// a state machine: 0 -> 1 -> 2 -> 0 -> 3, with a self loop 1 -> 1
+	g := NewGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(0, 3)
+	g.AddEdge(1, 1)
+
+	path, ok := g.EulerianPath()
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 1, 1, 2, 0, 3}, path)
+	assertEulerian(t, g, false, path)
+
+	_, ok = g.EulerianCircuit()
+	assert.False(t, ok)
+
+	// close the circuit
+	g.AddEdge(3, 0)
+	path, ok = EulerianCircuit(g)
+	assert.True(t, ok)
+	assert.Equal(t, path[0], path[len(path)-1])
+	assertEulerian(t, g, false, path)
+
+	// two vertices with an extra outgoing edge
+	g.AddEdge(2, 3)
+	g.AddEdge(2, 1)
+	_, ok = g.EulerianPath()
+	assert.False(t, ok)
+
+	// disconnected edges
+	g = NewGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 0)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 2)
+	_, ok = g.EulerianCircuit()
+	assert.False(t, ok)
+
+	// no edges
+	path, ok = NewGraph(2).EulerianCircuit()
+	assert.True(t, ok)
+	assert.Equal(t, []int{}, path)
+}
+
+func TestEulerianCircuit_FetchesNeighborsOncePerVertex(t *testing.T) {
+	// the circuit 0 -> 1 -> ... -> 9 -> 0 on a matrix graph
+	m := NewAdjacencyMatrix(10)
+	for u := 0; u < 10; u++ {
+		m.AddEdge(u, (u+1)%10)
+	}
+	g := &neighborsCountingGraph{GraphInterface: m}
+	circuit, ok := EulerianCircuit(g)
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 0}, circuit)
+	// once for the degrees, once for picking the start & once for the traversal
+	assert.LessOrEqual(t, g.calls, 3*10)
+}
+
+func TestUndirectedGraph_EulerianPath(t *testing.T) {
+	// the house of Santa Claus: 0..3 a square with both diagonals, 4 the roof on top of 2 & 3
+	g := NewUndirectedGraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 0)
+	g.AddEdge(0, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 4)
+	g.AddEdge(3, 4)
+
+	path, ok := g.EulerianPath()
+	assert.True(t, ok)
+	assert.Len(t, path, 9)
+	// starts & ends at the odd vertices
+	assert.Equal(t, 0, path[0])
+	assert.Equal(t, 1, path[len(path)-1])
+	assertEulerian(t, g, true, path)
+	_, ok = g.EulerianCircuit()
+	assert.False(t, ok)
+
+	// parallel edges & a self loop keep the degrees even
+	g.AddEdge(0, 1)
+	g.AddEdge(4, 4)
+	path, ok = g.EulerianCircuit()
+	assert.True(t, ok)
+	assert.Len(t, path, 11)
+	assertEulerian(t, g, true, path)
+
+	// more than two odd vertices
+	g = NewUndirectedGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(0, 3)
+	_, ok = g.EulerianPath()
+	assert.False(t, ok)
+}
+
+func TestGraph_HamiltonianPath(t *testing.T) {
+	g := NewGraph(4)
+	g.AddEdge(0, 2)
+	g.AddEdge(2, 1)
+	g.AddEdge(1, 3)
+	g.AddEdge(0, 1)
+
+	path, ok, err := g.HamiltonianPath(10)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 2, 1, 3}, path)
+
+	_, ok, err = g.HamiltonianCycle(10)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	g.AddEdge(3, 0)
+	path, ok, err = HamiltonianCycle(g, 10)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 2, 1, 3}, path)
+
+	// no path through a vertex with no edges
+	_, ok, err = HamiltonianPath(NewGraph(2), 10)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	// the size limit
+	_, _, err = g.HamiltonianPath(3)
+	assert.Equal(t, ERR_GRAPH_TOO_LARGE, err)
+
+	// an undirected graph: a star has no Hamiltonian path
+	u := NewUndirectedGraph(4)
+	u.AddEdge(0, 1)
+	u.AddEdge(0, 2)
+	u.AddEdge(0, 3)
+	_, ok, err = HamiltonianPath(u, 10)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	u.AddEdge(1, 2)
+	path, ok, _ = HamiltonianPath(u, 10)
+	assert.True(t, ok)
+	assert.Equal(t, []int{1, 2, 0, 3}, path)
+}
@@ -0,0 +1,228 @@
+/*
+executor.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements a concurrent executor of the tasks modelled as a directed acyclic graph (DAG)
+
+package adt
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+)
+
+// VertexStatus denotes the outcome of the task of a vertex run by an Executor
+type VertexStatus int
+
+const (
+	VertexPending   VertexStatus = iota // the task never ran
+	VertexSucceeded                     // the task ran & returned no error
+	VertexFailed                        // the task ran & returned an error
+	VertexSkipped                       // the task did not run, as the task of a predecessor failed or was skipped
+	VertexCanceled                      // the task did not run (or was interrupted), as the execution was canceled
+)
+
+func (s VertexStatus) String() string {
+	switch s {
+	case VertexPending:
+		return "pending"
+	case VertexSucceeded:
+		return "succeeded"
+	case VertexFailed:
+		return "failed"
+	case VertexSkipped:
+		return "skipped"
+	case VertexCanceled:
+		return "canceled"
+	}
+	return "unknown"
+}
+
+// VertexResult is the report of the task of a vertex run by an Executor
+type VertexResult struct {
+	Vertex int
+	Status VertexStatus
+	Err    error // the error returned by the task, if any
+}
+
+// Executor runs a task for every vertex of a DAG, each one only after the tasks of all of its predecessors
+// (i.e. the vertices having an edge to it) have succeeded. The independent tasks run concurrently.
+type Executor struct {
+	graph GraphInterface
+	task  func(ctx context.Context, vertex int) error
+	// Workers is the max number of tasks running at a time; defaults to the number of CPUs
+	Workers int
+	// ContinueOnError keeps running the tasks not depending on a failed task, instead of canceling all on first failure
+	ContinueOnError bool
+}
+
+// NewExecutor creates & returns an Executor running the given task for every vertex of the given DAG
+func NewExecutor(g GraphInterface, task func(ctx context.Context, vertex int) error) *Executor {
+	return &Executor{graph: g, task: task}
+}
+
+// Run runs the tasks and returns the result of each vertex, along with the first error returned by a task.
+// On the first failure, the context passed to the running tasks is canceled & no more tasks are started,
+// unless ContinueOnError is set; in which case only the tasks depending on the failed one are skipped.
+// If the given context is canceled (or its deadline passes), no more tasks are started & its error is returned;
+// the tasks failing from then on are reported as canceled.
+// If the graph has a cycle, no task is run & a *CycleError is returned.
+func (e *Executor) Run(ctx context.Context) ([]VertexResult, error) {
+	g := e.graph
+	if cycle, hasCycle := FindCycle(g); hasCycle {
+		return nil, &CycleError{Cycle: cycle}
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workers := e.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	results := make([]VertexResult, g.NumVertices())
+	for vertex := range results {
+		results[vertex] = VertexResult{Vertex: vertex, Status: VertexPending}
+	}
+
+	// start the workers
+	jobs := make(chan int)
+	done := make(chan VertexResult)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for vertex := range jobs {
+				done <- VertexResult{Vertex: vertex, Err: e.task(runCtx, vertex)}
+			}
+		}()
+	}
+
+	// to hold the in-degrees of each vertex i.e. the number of its predecessors yet to finish
+	inDegreeMap := inDegrees(g)
+	// a memory map to flag the vertices having a failed or skipped predecessor
+	blocked := map[int]bool{}
+	// the vertices whose predecessors have all succeeded
+	ready := NewQueue()
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		if inDegreeMap[vertex] == 0 {
+			ready.Enqueue(vertex)
+		}
+	}
+
+	var firstErr error
+	// whether to stop starting new tasks
+	stopped := false
+	// number of tasks running at the moment
+	running := 0
+	// the next vertex to hand over to a worker, -1 if none
+	next := -1
+
+	// release the successors of a finished vertex, skipping them (transitively) if it did not succeed
+	var release func(vertex int, succeeded bool)
+	release = func(vertex int, succeeded bool) {
+		for _, neighbor := range g.Neighbors(vertex) {
+			if !succeeded {
+				blocked[neighbor] = true
+			}
+			inDegreeMap[neighbor]--
+			if inDegreeMap[neighbor] != 0 {
+				continue
+			}
+			if blocked[neighbor] {
+				// once stopped, the vertices which never got to run are reported as canceled instead
+				if !stopped {
+					results[neighbor].Status = VertexSkipped
+					release(neighbor, false)
+				}
+				continue
+			}
+			ready.Enqueue(neighbor)
+		}
+	}
+
+	handle := func(result VertexResult) {
+		running--
+		// stop starting new tasks, once the execution is canceled by the caller
+		if ctx.Err() != nil {
+			stopped = true
+		}
+		if result.Err == nil {
+			results[result.Vertex].Status = VertexSucceeded
+			release(result.Vertex, true)
+			return
+		}
+		results[result.Vertex].Err = result.Err
+		if ctx.Err() != nil || (stopped && errors.Is(result.Err, context.Canceled)) {
+			// the task was interrupted, either by the caller (e.g. canceled or past its deadline) or by us
+			results[result.Vertex].Status = VertexCanceled
+		} else {
+			results[result.Vertex].Status = VertexFailed
+			if firstErr == nil {
+				firstErr = result.Err
+			}
+			if !e.ContinueOnError {
+				stopped = true
+				cancel()
+			}
+		}
+		release(result.Vertex, false)
+	}
+
+	for {
+		// stop starting new tasks, once the execution is canceled by the caller
+		if ctx.Err() != nil {
+			stopped = true
+		}
+
+		if next == -1 && !stopped && !ready.IsEmpty() {
+			n, err := ready.Dequeue()
+			if err != nil {
+				panic(err)
+			}
+			next = n.(int)
+		}
+
+		if next != -1 && !stopped {
+			// hand over the next vertex, or handle a finished one, whichever happens first
+			select {
+			case jobs <- next:
+				running++
+				next = -1
+			case result := <-done:
+				handle(result)
+			case <-ctx.Done():
+			}
+			continue
+		}
+
+		if running == 0 {
+			break
+		}
+		// nothing to hand over, so wait for a running task to finish
+		handle(<-done)
+	}
+	close(jobs)
+	wg.Wait()
+
+	// the vertices which never got to run
+	if stopped {
+		for vertex := range results {
+			if results[vertex].Status == VertexPending {
+				results[vertex].Status = VertexCanceled
+			}
+		}
+	}
+
+	if firstErr == nil && ctx.Err() != nil {
+		return results, ctx.Err()
+	}
+	return results, firstErr
+}
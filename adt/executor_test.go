@@ -0,0 +1,163 @@
+/*
+executor_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newExecutorTestGraph creates the graph:
+//
+//	0 --> 1 --> 3
+//	 \         ^
+//	  --> 2 --/
+//	4 --> 5
+func newExecutorTestGraph() *Graph {
+	g := NewGraph(6)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 3)
+	g.AddEdge(4, 5)
+	return g
+}
+
+func TestExecutor_Run(t *testing.T) {
+	g := newExecutorTestGraph()
+
+	var mu sync.Mutex
+	finished := map[int]bool{}
+	e := NewExecutor(g, func(ctx context.Context, vertex int) error {
+		mu.Lock()
+		defer mu.Unlock()
+		// all the predecessors must have finished
+		for u, neighbors := range g.AdjacencyList {
+			for _, v := range neighbors {
+				if v == vertex && !finished[u] {
+					t.Errorf("vertex %v ran before its predecessor %v", vertex, u)
+				}
+			}
+		}
+		finished[vertex] = true
+		return nil
+	})
+	e.Workers = 3
+
+	results, err := e.Run(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, results, 6)
+	for vertex, result := range results {
+		assert.Equal(t, vertex, result.Vertex)
+		assert.Equal(t, VertexSucceeded, result.Status)
+		assert.Nil(t, result.Err)
+	}
+}
+
+func TestExecutor_Run_CancelOnError(t *testing.T) {
+	g := newExecutorTestGraph()
+	errBoom := errors.New("boom")
+
+	e := NewExecutor(g, func(ctx context.Context, vertex int) error {
+		if vertex == 0 {
+			return errBoom
+		}
+		return nil
+	})
+	// a single worker makes the order deterministic: 0 runs first
+	e.Workers = 1
+
+	results, err := e.Run(context.Background())
+	assert.Equal(t, errBoom, err)
+	assert.Equal(t, VertexFailed, results[0].Status)
+	assert.Equal(t, errBoom, results[0].Err)
+	for _, vertex := range []int{1, 2, 3, 4, 5} {
+		assert.NotEqual(t, VertexSucceeded, results[vertex].Status, "vertex %v", vertex)
+		assert.NotEqual(t, VertexPending, results[vertex].Status, "vertex %v", vertex)
+	}
+}
+
+func TestExecutor_Run_ContinueOnError(t *testing.T) {
+	g := newExecutorTestGraph()
+	errBoom := errors.New("boom")
+
+	e := NewExecutor(g, func(ctx context.Context, vertex int) error {
+		if vertex == 1 {
+			return errBoom
+		}
+		return nil
+	})
+	e.ContinueOnError = true
+
+	results, err := e.Run(context.Background())
+	assert.Equal(t, errBoom, err)
+	assert.Equal(t, VertexSucceeded, results[0].Status)
+	assert.Equal(t, VertexFailed, results[1].Status)
+	assert.Equal(t, VertexSucceeded, results[2].Status)
+	assert.Equal(t, VertexSkipped, results[3].Status)
+	assert.Equal(t, VertexSucceeded, results[4].Status)
+	assert.Equal(t, VertexSucceeded, results[5].Status)
+}
+
+func TestExecutor_Run_Canceled(t *testing.T) {
+	g := newExecutorTestGraph()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	e := NewExecutor(g, func(ctx context.Context, vertex int) error {
+		// cancel the execution from within the first task, and honour the cancellation
+		cancel()
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	e.Workers = 1
+
+	results, err := e.Run(ctx)
+	assert.Equal(t, context.Canceled, err)
+	for _, result := range results {
+		assert.Equal(t, VertexCanceled, result.Status)
+	}
+}
+
+func TestExecutor_Run_DeadlineExceeded(t *testing.T) {
+	g := newExecutorTestGraph()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	e := NewExecutor(g, func(ctx context.Context, vertex int) error {
+		// outlive the deadline from within the first task, and honour it
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	e.Workers = 1
+
+	results, err := e.Run(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	for _, result := range results {
+		assert.Equal(t, VertexCanceled, result.Status)
+	}
+}
+
+func TestExecutor_Run_Cyclic(t *testing.T) {
+	g := NewGraph(2)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 0)
+
+	ran := false
+	results, err := NewExecutor(g, func(ctx context.Context, vertex int) error {
+		ran = true
+		return nil
+	}).Run(context.Background())
+	assert.Nil(t, results)
+	assert.True(t, errors.Is(err, ERR_GRAPH_HAS_CYCLE))
+	assert.False(t, ran)
+}
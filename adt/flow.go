@@ -0,0 +1,243 @@
+/*
+flow.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements Maximum Flow algorithms on capacitated directed Graphs
+
+package adt
+
+import (
+	"math"
+
+	myerr "github.com/toransahu/goutils/errors"
+)
+
+var ERR_SOURCE_IS_SINK myerr.UserDefinedError = "source and sink are the same vertex"
+
+// flowEpsilon is the residual capacity below which an edge is considered saturated
+const flowEpsilon = 1e-9
+
+// FlowResult holds the result of a maximum flow computation
+type FlowResult struct {
+	// Value is the total flow from the source to the sink
+	Value float64
+	// Flow holds the flow on each edge, aligned with AdjacencyList
+	// i.e. Flow[u][i] is the flow on the edge u -> AdjacencyList[u][i]
+	Flow [][]float64
+	// MinCut holds the edges of a minimum cut i.e. the edges going from the source side to the sink side,
+	// whose total capacity equals the max flow; these are the bottlenecks of the network
+	MinCut []Edge
+}
+
+// MaxFlowEdmondsKarp finds the maximum flow from the source to the sink using Edmonds-Karp algo
+// i.e. it keeps pushing flow along the shortest (fewest edges) augmenting path, found using BFS.
+// The weight of an edge is its capacity.
+// Time Complexity: O(V.E^2)
+func MaxFlowEdmondsKarp(g GraphInterface, source int, sink int) (*FlowResult, error) {
+	r, err := newResidualNetwork(g, source, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	value := 0.0
+	for {
+		// find the shortest augmenting path, as the arc used to reach each vertex
+		via := make([]int, len(r.adjacency))
+		for vertex := range via {
+			via[vertex] = -1
+		}
+		q := NewQueue()
+		q.Enqueue(source)
+		for !q.IsEmpty() && via[sink] == -1 {
+			n, err := q.Dequeue()
+			if err != nil {
+				panic(err)
+			}
+			node := n.(int)
+			for _, arc := range r.adjacency[node] {
+				to := r.to[arc]
+				if to != source && via[to] == -1 && r.capacity[arc] > flowEpsilon {
+					via[to] = arc
+					q.Enqueue(to)
+				}
+			}
+		}
+		// no augmenting path left, so the flow is maximum
+		if via[sink] == -1 {
+			break
+		}
+
+		// the bottleneck (least residual capacity) of the path
+		bottleneck := math.Inf(1)
+		for vertex := sink; vertex != source; vertex = r.to[via[vertex]^1] {
+			bottleneck = math.Min(bottleneck, r.capacity[via[vertex]])
+		}
+		// push the flow along the path
+		for vertex := sink; vertex != source; vertex = r.to[via[vertex]^1] {
+			r.push(via[vertex], bottleneck)
+		}
+		value += bottleneck
+	}
+	return r.result(g, source, value), nil
+}
+
+// MaxFlowEdmondsKarp is a shorthand for MaxFlowEdmondsKarp(g, source, sink)
+func (g *Graph) MaxFlowEdmondsKarp(source int, sink int) (*FlowResult, error) {
+	return MaxFlowEdmondsKarp(g, source, sink)
+}
+
+// MaxFlowDinic finds the maximum flow from the source to the sink using Dinic's algo
+// i.e. it builds a level graph (using BFS) and saturates it with a blocking flow (using DFS), until the sink is unreachable.
+// The weight of an edge is its capacity. It is much faster than Edmonds-Karp on larger networks.
+// Time Complexity: O(V^2.E)
+func MaxFlowDinic(g GraphInterface, source int, sink int) (*FlowResult, error) {
+	r, err := newResidualNetwork(g, source, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	value := 0.0
+	for {
+		// build the level graph i.e. the distance (in edges) of each vertex from the source
+		level := r.levels(source)
+		if level[sink] == -1 {
+			break
+		}
+		// the next arc to try for each vertex, so that the dead ends are not tried again
+		next := make([]int, len(r.adjacency))
+		for {
+			pushed := r.blockingFlow(source, sink, math.Inf(1), level, next)
+			if pushed <= flowEpsilon {
+				break
+			}
+			value += pushed
+		}
+	}
+	return r.result(g, source, value), nil
+}
+
+// MaxFlowDinic is a shorthand for MaxFlowDinic(g, source, sink)
+func (g *Graph) MaxFlowDinic(source int, sink int) (*FlowResult, error) {
+	return MaxFlowDinic(g, source, sink)
+}
+
+// residualNetwork is the residual network of a capacitated graph used by the max flow algos
+// Every edge of the graph becomes a pair of arcs: the forward arc 2k having the residual capacity,
+// and the backward arc 2k+1 having the flow (which can be pushed back); so arc^1 is the pair of the arc.
+type residualNetwork struct {
+	// the arcs going out of each vertex
+	adjacency [][]int
+	// the head vertex & the residual capacity of each arc
+	to       []int
+	capacity []float64
+}
+
+// newResidualNetwork (private func) validates the input & builds the residual network of the graph
+func newResidualNetwork(g GraphInterface, source int, sink int) (*residualNetwork, error) {
+	if source < 0 || source >= g.NumVertices() || sink < 0 || sink >= g.NumVertices() {
+		return nil, ERR_VERTEX_OUT_OF_RANGE
+	}
+	if source == sink {
+		return nil, ERR_SOURCE_IS_SINK
+	}
+
+	r := &residualNetwork{adjacency: make([][]int, g.NumVertices())}
+	for u := 0; u < g.NumVertices(); u++ {
+		neighbors, weights := g.Neighbors(u), g.EdgeWeights(u)
+		for idx, v := range neighbors {
+			capacity := weights[idx]
+			if capacity < 0 {
+				return nil, ERR_NEGATIVE_EDGE_WEIGHT
+			}
+			r.adjacency[u] = append(r.adjacency[u], len(r.to))
+			r.to = append(r.to, v)
+			r.capacity = append(r.capacity, capacity)
+			r.adjacency[v] = append(r.adjacency[v], len(r.to))
+			r.to = append(r.to, u)
+			r.capacity = append(r.capacity, 0)
+		}
+	}
+	return r, nil
+}
+
+// push (private func) pushes the given flow through the arc
+func (r *residualNetwork) push(arc int, flow float64) {
+	r.capacity[arc] -= flow
+	r.capacity[arc^1] += flow
+}
+
+// levels (private func) finds the distance (in arcs having residual capacity) of each vertex from the source using BFS
+// The unreachable vertices are at level -1.
+func (r *residualNetwork) levels(source int) []int {
+	level := make([]int, len(r.adjacency))
+	for vertex := range level {
+		level[vertex] = -1
+	}
+	level[source] = 0
+	q := NewQueue()
+	q.Enqueue(source)
+	for !q.IsEmpty() {
+		n, err := q.Dequeue()
+		if err != nil {
+			panic(err)
+		}
+		node := n.(int)
+		for _, arc := range r.adjacency[node] {
+			if to := r.to[arc]; level[to] == -1 && r.capacity[arc] > flowEpsilon {
+				level[to] = level[node] + 1
+				q.Enqueue(to)
+			}
+		}
+	}
+	return level
+}
+
+// blockingFlow (private func) pushes (at most the given) flow from the vertex to the sink along the level graph using DFS
+// It returns the flow pushed.
+func (r *residualNetwork) blockingFlow(vertex int, sink int, flow float64, level []int, next []int) float64 {
+	if vertex == sink {
+		return flow
+	}
+	for ; next[vertex] < len(r.adjacency[vertex]); next[vertex]++ {
+		arc := r.adjacency[vertex][next[vertex]]
+		to := r.to[arc]
+		// follow only the arcs going one level deeper, having residual capacity
+		if level[to] != level[vertex]+1 || r.capacity[arc] <= flowEpsilon {
+			continue
+		}
+		if pushed := r.blockingFlow(to, sink, math.Min(flow, r.capacity[arc]), level, next); pushed > flowEpsilon {
+			r.push(arc, pushed)
+			return pushed
+		}
+	}
+	return 0
+}
+
+// result (private func) builds the FlowResult of the graph from the residual network carrying the max flow
+func (r *residualNetwork) result(g GraphInterface, source int, value float64) *FlowResult {
+	result := &FlowResult{Value: value, Flow: make([][]float64, g.NumVertices()), MinCut: []Edge{}}
+
+	// the vertices still reachable from the source (via the arcs having residual capacity)
+	// form the source side of a min cut
+	level := r.levels(source)
+
+	arc := 0
+	for u := 0; u < g.NumVertices(); u++ {
+		neighbors, weights := g.Neighbors(u), g.EdgeWeights(u)
+		result.Flow[u] = make([]float64, len(neighbors))
+		for idx, v := range neighbors {
+			// the flow on an edge is the residual capacity of its backward arc
+			result.Flow[u][idx] = r.capacity[arc+1]
+			arc += 2
+
+			// the edges crossing from the source side to the sink side are saturated, and form the min cut
+			if level[u] != -1 && level[v] == -1 {
+				result.MinCut = append(result.MinCut, Edge{From: u, To: v, Weight: weights[idx]})
+			}
+		}
+	}
+	return result
+}
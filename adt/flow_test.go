@@ -0,0 +1,85 @@
+/*
+flow_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_MaxFlow(t *testing.T) {
+	// the classic network from CLRS; source 0, sink 5
+	g := NewGraph(6)
+	g.AddWeightedEdge(0, 1, 16)
+	g.AddWeightedEdge(0, 2, 13)
+	g.AddWeightedEdge(1, 2, 10)
+	g.AddWeightedEdge(2, 1, 4)
+	g.AddWeightedEdge(1, 3, 12)
+	g.AddWeightedEdge(3, 2, 9)
+	g.AddWeightedEdge(2, 4, 14)
+	g.AddWeightedEdge(4, 3, 7)
+	g.AddWeightedEdge(3, 5, 20)
+	g.AddWeightedEdge(4, 5, 4)
+
+	algos := map[string]func(int, int) (*FlowResult, error){
+		"EdmondsKarp": g.MaxFlowEdmondsKarp,
+		"Dinic":       g.MaxFlowDinic,
+	}
+	for name, maxFlow := range algos {
+		result, err := maxFlow(0, 5)
+		assert.Nil(t, err, name)
+		assert.InDelta(t, 23.0, result.Value, 1e-9, name)
+
+		// capacity & conservation constraints
+		balance := make([]float64, 6)
+		for u, neighbors := range g.AdjacencyList {
+			for idx, v := range neighbors {
+				flow := result.Flow[u][idx]
+				assert.True(t, flow >= 0 && flow <= g.Weights[u][idx], name)
+				balance[u] -= flow
+				balance[v] += flow
+			}
+		}
+		assert.InDelta(t, -23.0, balance[0], 1e-9, name)
+		assert.InDelta(t, 23.0, balance[5], 1e-9, name)
+		for vertex := 1; vertex < 5; vertex++ {
+			assert.InDelta(t, 0.0, balance[vertex], 1e-9, name)
+		}
+
+		// the min cut capacity equals the max flow
+		cut := 0.0
+		for _, edge := range result.MinCut {
+			cut += edge.Weight
+		}
+		assert.InDelta(t, 23.0, cut, 1e-9, name)
+		assert.ElementsMatch(t, []Edge{{1, 3, 12}, {4, 3, 7}, {4, 5, 4}}, result.MinCut, name)
+	}
+}
+
+func TestGraph_MaxFlow_Errors(t *testing.T) {
+	g := NewGraph(3)
+	g.AddWeightedEdge(0, 1, 1)
+
+	for name, maxFlow := range map[string]func(int, int) (*FlowResult, error){"EdmondsKarp": g.MaxFlowEdmondsKarp, "Dinic": g.MaxFlowDinic} {
+		// unreachable sink
+		result, err := maxFlow(0, 2)
+		assert.Nil(t, err, name)
+		assert.Equal(t, 0.0, result.Value, name)
+		assert.Equal(t, []Edge{}, result.MinCut, name)
+
+		_, err = maxFlow(0, 0)
+		assert.Equal(t, ERR_SOURCE_IS_SINK, err, name)
+		_, err = maxFlow(0, 3)
+		assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err, name)
+	}
+
+	g.AddWeightedEdge(1, 2, -1)
+	_, err := g.MaxFlowDinic(0, 2)
+	assert.Equal(t, ERR_NEGATIVE_EDGE_WEIGHT, err)
+}
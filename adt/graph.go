@@ -6,62 +6,398 @@ Distributed under terms of the MIT license.
 */
 
 // This file implements Graphs
-// Roadmap: provide interface; option to create new graph based on implementation type (e.g. AdjacencyMatrix, AdjacencyList etc.); Option to init the graph; create diff. types of graph (e.g. Directed, Undirected etc.) - where attributes/operations are diff.
+// The algorithms accept a GraphInterface, implemented by Graph (Adjacency List), AdjacencyMatrix & CSRGraph;
+// and are also available as the methods of Graph.
 
 package adt
 
+import (
+	"fmt"
+	"sort"
+
+	"github.com/toransahu/goutils/adt/heap"
+	myerr "github.com/toransahu/goutils/errors"
+)
+
+var ERR_GRAPH_HAS_CYCLE myerr.UserDefinedError = "graph has a cycle"
+
+// CycleError is the error reported when a cycle is found in a graph which must be acyclic
+// It wraps ERR_GRAPH_HAS_CYCLE, and carries the vertices of the cycle in the order of its edges.
+type CycleError struct {
+	Cycle []int
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("%s: %v", ERR_GRAPH_HAS_CYCLE, e.Cycle)
+}
+
+// Unwrap makes errors.Is(err, ERR_GRAPH_HAS_CYCLE) work for a CycleError
+func (e *CycleError) Unwrap() error {
+	return ERR_GRAPH_HAS_CYCLE
+}
+
+// GraphInterface describes the requirements for a graph type using the algorithms in this package
+// It is read-only, as the algorithms do not modify the graph; see MutableGraphInterface for the mutable graph types.
+// The vertices of a graph are the integers 0..NumVertices()-1.
+type GraphInterface interface {
+	// NumVertices returns the number of vertices in the graph
+	NumVertices() int
+	// Neighbors returns the vertices adjacent to the vertex i.e. the heads of its outgoing edges
+	Neighbors(vertex int) []int
+	// EdgeWeights returns the weights of the outgoing edges of the vertex, aligned with Neighbors(vertex)
+	EdgeWeights(vertex int) []float64
+	// HasEdge tells whether the edge u -> v exists
+	HasEdge(u int, v int) bool
+}
+
+// MutableGraphInterface describes a graph type whose edges can be added & removed e.g. Graph, UndirectedGraph
+// & AdjacencyMatrix (but not CSRGraph, which is immutable)
+type MutableGraphInterface interface {
+	GraphInterface
+	// AddEdge inserts the edge u -> v of weight 1
+	AddEdge(u int, v int)
+	// AddWeightedEdge inserts the edge u -> v of the given weight
+	AddWeightedEdge(u int, v int, weight float64)
+	// RemoveEdge deletes the edge u -> v (all of them, in case of parallel edges), and tells whether it existed
+	RemoveEdge(u int, v int) bool
+}
+
+// AttributedGraphInterface describes a graph type holding the attributes of its vertices & edges e.g. Graph
+// The serializers (e.g. WriteEdgeList, WriteGraphML & WriteDOT) write the attributes of such a graph.
+type AttributedGraphInterface interface {
+	// VertexAttributeMap returns the attributes of the vertices having any
+	VertexAttributeMap() map[int]Attributes
+	// EdgeAttributeMap returns the attributes of the edges having any, keyed by [u, v]
+	EdgeAttributeMap() map[[2]int]Attributes
+}
+
 // Graph denotes a Graph data structure
 type Graph struct {
 	Vertices      int
 	AdjacencyList [][]int
+	// Weights holds the weight of each edge, aligned with AdjacencyList
+	// i.e. Weights[u][i] is the weight of the edge u -> AdjacencyList[u][i]
+	Weights [][]float64
+	// VertexAttributes holds the attributes (e.g. a name) of the vertices having any
+	VertexAttributes map[int]Attributes
+	// EdgeAttributes holds the attributes of the edges having any, keyed by [u, v] i.e. shared by the parallel edges
+	EdgeAttributes map[[2]int]Attributes
+}
+
+// Attributes holds the key-value attributes of a vertex or an edge, e.g. a name or a color
+type Attributes map[string]string
+
+// Edge denotes a (weighted) edge of a graph
+type Edge struct {
+	From   int
+	To     int
+	Weight float64
 }
 
 // NewGraph creates & returns a Directed Graph implemented using Adjacency List
 func NewGraph(numOfVertices int) *Graph {
 	// create graph struct
-	graph := &Graph{Vertices: numOfVertices, AdjacencyList: make([][]int, numOfVertices)}
+	graph := &Graph{Vertices: numOfVertices, AdjacencyList: make([][]int, numOfVertices), Weights: make([][]float64, numOfVertices)}
 
 	// init graph.AdjacencyMatrix with Zeros of [numOfVertices X numOfVertices] matrix
 	for idx := range graph.AdjacencyList {
 		// graph.AdjacencyList[idx] = make([]int, numOfVertices)
 		graph.AdjacencyList[idx] = []int{}
+		graph.Weights[idx] = []float64{}
 	}
 	return graph
 }
 
 // AddEdge inserts edge to the directed graph
+// An unweighted edge is treated as an edge of weight 1 by the weighted algorithms
 func (g *Graph) AddEdge(u int, v int) {
+	g.AddWeightedEdge(u, v, 1)
+}
+
+// AddWeightedEdge inserts an edge of the given weight (aka cost) to the directed graph
+func (g *Graph) AddWeightedEdge(u int, v int, weight float64) {
+	// keep Weights aligned with AdjacencyList, in case the graph was not created by NewGraph
+	g.alignWeights(u)
 	g.AdjacencyList[u] = append(g.AdjacencyList[u], v)
+	g.Weights[u] = append(g.Weights[u], weight)
+}
+
+// NumVertices returns the number of vertices in the graph
+func (g *Graph) NumVertices() int {
+	return len(g.AdjacencyList)
+}
+
+// Neighbors returns the vertices adjacent to the vertex i.e. the heads of its outgoing edges
+func (g *Graph) Neighbors(vertex int) []int {
+	return g.AdjacencyList[vertex]
+}
+
+// EdgeWeights returns the weights of the outgoing edges of the vertex, aligned with Neighbors(vertex)
+// Edges appended directly to AdjacencyList (without a weight) are of weight 1
+func (g *Graph) EdgeWeights(vertex int) []float64 {
+	if vertex < len(g.Weights) && len(g.Weights[vertex]) == len(g.AdjacencyList[vertex]) {
+		return g.Weights[vertex]
+	}
+	weights := make([]float64, len(g.AdjacencyList[vertex]))
+	for idx := range weights {
+		weights[idx] = g.weightAt(vertex, idx)
+	}
+	return weights
+}
+
+// HasEdge tells whether the edge u -> v exists
+func (g *Graph) HasEdge(u int, v int) bool {
+	for _, neighbor := range g.AdjacencyList[u] {
+		if neighbor == v {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveEdge deletes the edge u -> v (all of them, in case of parallel edges), and tells whether it existed
+func (g *Graph) RemoveEdge(u int, v int) bool {
+	g.alignWeights(u)
+	neighbors, weights := g.AdjacencyList[u][:0], g.Weights[u][:0]
+	for idx, neighbor := range g.AdjacencyList[u] {
+		if neighbor != v {
+			neighbors = append(neighbors, neighbor)
+			weights = append(weights, g.Weights[u][idx])
+		}
+	}
+	removed := len(neighbors) != len(g.AdjacencyList[u])
+	g.AdjacencyList[u], g.Weights[u] = neighbors, weights
+	if removed {
+		delete(g.EdgeAttributes, [2]int{u, v})
+	}
+	return removed
+}
+
+// AddVertex appends a new vertex (with no edges) to the graph and returns it
+func (g *Graph) AddVertex() int {
+	// keep Weights aligned with AdjacencyList, in case the graph was not created by NewGraph
+	for len(g.Weights) < len(g.AdjacencyList) {
+		g.Weights = append(g.Weights, []float64{})
+	}
+	g.AdjacencyList = append(g.AdjacencyList, []int{})
+	g.Weights = append(g.Weights, []float64{})
+	g.Vertices = len(g.AdjacencyList)
+	return g.Vertices - 1
+}
+
+// RemoveVertex deletes the vertex along with all of its outgoing & incoming edges
+// As the vertices are the integers 0..V-1, every vertex after the removed one is renumbered to one less
+// i.e. the vertex w > vertex becomes w-1, and so do all the edges to it.
+// Time Complexity: O(V + E)
+func (g *Graph) RemoveVertex(vertex int) {
+	if vertex < 0 || vertex >= len(g.AdjacencyList) {
+		panic(ERR_VERTEX_OUT_OF_RANGE)
+	}
+	// keep Weights aligned with AdjacencyList, in case the graph was not created by NewGraph
+	for u := range g.AdjacencyList {
+		g.alignWeights(u)
+	}
+
+	// drop the row of the vertex i.e. its outgoing edges
+	g.AdjacencyList = append(g.AdjacencyList[:vertex], g.AdjacencyList[vertex+1:]...)
+	g.Weights = append(g.Weights[:vertex], g.Weights[vertex+1:]...)
+	g.Vertices = len(g.AdjacencyList)
+
+	// drop the incoming edges, and renumber the heads of the remaining ones
+	for u := range g.AdjacencyList {
+		neighbors, weights := g.AdjacencyList[u][:0], g.Weights[u][:0]
+		for idx, neighbor := range g.AdjacencyList[u] {
+			if neighbor == vertex {
+				continue
+			}
+			if neighbor > vertex {
+				neighbor--
+			}
+			neighbors = append(neighbors, neighbor)
+			weights = append(weights, g.Weights[u][idx])
+		}
+		g.AdjacencyList[u], g.Weights[u] = neighbors, weights
+	}
+
+	// renumber the attributes as well
+	renumber := func(w int) int {
+		if w > vertex {
+			return w - 1
+		}
+		return w
+	}
+	if g.VertexAttributes != nil {
+		vertexAttributes := map[int]Attributes{}
+		for w, attrs := range g.VertexAttributes {
+			if w != vertex {
+				vertexAttributes[renumber(w)] = attrs
+			}
+		}
+		g.VertexAttributes = vertexAttributes
+	}
+	if g.EdgeAttributes != nil {
+		edgeAttributes := map[[2]int]Attributes{}
+		for edge, attrs := range g.EdgeAttributes {
+			if edge[0] != vertex && edge[1] != vertex {
+				edgeAttributes[[2]int{renumber(edge[0]), renumber(edge[1])}] = attrs
+			}
+		}
+		g.EdgeAttributes = edgeAttributes
+	}
+}
+
+// Transpose creates & returns the transpose of the graph i.e. having every edge u -> v reversed as v -> u
+// Time Complexity: O(V + E)
+func Transpose(g GraphInterface) *Graph {
+	transpose := NewGraph(g.NumVertices())
+	for u := 0; u < g.NumVertices(); u++ {
+		weights := g.EdgeWeights(u)
+		for idx, v := range g.Neighbors(u) {
+			transpose.AddWeightedEdge(v, u, weights[idx])
+		}
+	}
+	return transpose
+}
+
+// Transpose is a shorthand for Transpose(g)
+func (g *Graph) Transpose() *Graph {
+	return Transpose(g)
+}
+
+// SetVertexAttribute sets the attribute of the vertex
+func (g *Graph) SetVertexAttribute(vertex int, key string, value string) {
+	if g.VertexAttributes == nil {
+		g.VertexAttributes = map[int]Attributes{}
+	}
+	if g.VertexAttributes[vertex] == nil {
+		g.VertexAttributes[vertex] = Attributes{}
+	}
+	g.VertexAttributes[vertex][key] = value
+}
+
+// VertexAttribute returns the attribute of the vertex, and whether it is set
+func (g *Graph) VertexAttribute(vertex int, key string) (string, bool) {
+	value, found := g.VertexAttributes[vertex][key]
+	return value, found
+}
+
+// SetEdgeAttribute sets the attribute of the edge u -> v (shared by the parallel edges, if any)
+func (g *Graph) SetEdgeAttribute(u int, v int, key string, value string) {
+	if g.EdgeAttributes == nil {
+		g.EdgeAttributes = map[[2]int]Attributes{}
+	}
+	edge := [2]int{u, v}
+	if g.EdgeAttributes[edge] == nil {
+		g.EdgeAttributes[edge] = Attributes{}
+	}
+	g.EdgeAttributes[edge][key] = value
+}
+
+// EdgeAttribute returns the attribute of the edge u -> v, and whether it is set
+func (g *Graph) EdgeAttribute(u int, v int, key string) (string, bool) {
+	value, found := g.EdgeAttributes[[2]int{u, v}][key]
+	return value, found
+}
+
+// VertexAttributeMap returns the attributes of the vertices having any i.e. VertexAttributes
+func (g *Graph) VertexAttributeMap() map[int]Attributes {
+	return g.VertexAttributes
+}
+
+// EdgeAttributeMap returns the attributes of the edges having any i.e. EdgeAttributes
+func (g *Graph) EdgeAttributeMap() map[[2]int]Attributes {
+	return g.EdgeAttributes
+}
+
+// OutDegree returns the number of outgoing edges of the vertex
+func (g *Graph) OutDegree(vertex int) int {
+	return len(g.AdjacencyList[vertex])
+}
+
+// InDegree returns the number of incoming edges of the vertex
+// Time Complexity: O(V + E)
+func (g *Graph) InDegree(vertex int) int {
+	degree := 0
+	for _, neighbors := range g.AdjacencyList {
+		for _, neighbor := range neighbors {
+			if neighbor == vertex {
+				degree++
+			}
+		}
+	}
+	return degree
+}
+
+// EdgeWeight returns the weight of the edge u -> v and whether such an edge exists
+// If there are parallel edges u -> v, the weight of the lightest one is returned
+func (g *Graph) EdgeWeight(u int, v int) (float64, bool) {
+	weight, found := 0.0, false
+	for idx, neighbor := range g.AdjacencyList[u] {
+		if neighbor != v {
+			continue
+		}
+		if w := g.weightAt(u, idx); !found || w < weight {
+			weight, found = w, true
+		}
+	}
+	return weight, found
+}
+
+// weightAt (private func) returns the weight of the edge u -> AdjacencyList[u][idx]
+// Edges appended directly to AdjacencyList (without a weight) are of weight 1
+func (g *Graph) weightAt(u int, idx int) float64 {
+	if u < len(g.Weights) && idx < len(g.Weights[u]) {
+		return g.Weights[u][idx]
+	}
+	return 1
+}
+
+// alignWeights (private func) pads Weights[u] with unit weights up to the length of AdjacencyList[u]
+func (g *Graph) alignWeights(u int) {
+	for len(g.Weights) < len(g.AdjacencyList) {
+		g.Weights = append(g.Weights, []float64{})
+	}
+	for len(g.Weights[u]) < len(g.AdjacencyList[u]) {
+		g.Weights[u] = append(g.Weights[u], 1)
+	}
 }
 
 // DFS traverse the graph in Depth First Order and returns the vertices in the order
-func (g *Graph) DFS() []int {
+func DFS(g GraphInterface) []int {
 	// to store the ordered vertices
 	result := []int{}
+	if g.NumVertices() == 0 {
+		return result
+	}
 	// a memory map to flag the visited vertices
 	visited := map[int]bool{}
 	// arbitrarily choose first vertex in the AdjacencyList to start DFS
 	vertex := 0 // Optional: for readability
 	// run the DFS algo for `vertex` using `visited` memory
 	// and append the result to `result`
-	g.dfs(vertex, &visited, &result) // Optional: for readability
+	dfs(g, vertex, &visited, &result) // Optional: for readability
 
 	// as this is a directed graph (and may be disconnected as well)
 	// there could be possibilities that a few vertices remain unreachable
 	// so in such case, iterate over all the vertices
-	for vertex := range g.AdjacencyList {
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
 		// and if they are not yet visited
 		if !visited[vertex] {
 			// run the DFS algo for the `vertex`
-			g.dfs(vertex, &visited, &result)
+			dfs(g, vertex, &visited, &result)
 		}
 	}
 
 	return result
 }
 
+// DFS is a shorthand for DFS(g)
+func (g *Graph) DFS() []int {
+	return DFS(g)
+}
+
 // dfs (private func) does the basic Depth First Traversal of the graph
-func (g *Graph) dfs(vertex int, visited *map[int]bool, result *[]int) {
+func dfs(g GraphInterface, vertex int, visited *map[int]bool, result *[]int) {
 	// create stack to help in backtracking whenever required
 	// however, we can use recursion as well - it is same as using an explicit stack
 	// as the recursive function calls will be stacked automatically
@@ -76,15 +412,20 @@ func (g *Graph) dfs(vertex int, visited *map[int]bool, result *[]int) {
 	*result = append(*result, vertex)
 
 	// iterate through all the adjacent vertices of the given vertex
-	for _, u := range g.AdjacencyList[vertex] {
+	for _, u := range g.Neighbors(vertex) {
 		// and run DFS for the adjacent vertex
-		g.dfs(u, visited, result)
+		dfs(g, u, visited, result)
 	}
 }
 
+// dfs is a shorthand for dfs(g, vertex, visited, result)
+func (g *Graph) dfs(vertex int, visited *map[int]bool, result *[]int) {
+	dfs(g, vertex, visited, result)
+}
+
 // TopoSort sorts the directed acyclic graph (DAG) into Topological order
 // A topological ordering is possible if and only if the graph has no directed cycles
-func (g *Graph) TopoSort() ([]int, bool) {
+func TopoSort(g GraphInterface) ([]int, bool) {
 	// to store the topological ordered vertices
 	result := []int{}
 	// stack to store the topological ordered vertices (in reverse order)
@@ -99,11 +440,11 @@ func (g *Graph) TopoSort() ([]int, bool) {
 	// because the graph could be disconnected
 	// or there could be some unreachable vertices when we start DFS/TopoSort from
 	// a random vertex
-	for vertex := range g.AdjacencyList {
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
 		// and if the vertex is not yet visited
 		if !visited[vertex] {
 			// run the TopoSort (a tweaked DFS) for the given vertex
-			hasCycle := g.topoSort(vertex, &visited, &stack, &recentlyVisited)
+			hasCycle := topoSort(g, vertex, &visited, &stack, &recentlyVisited)
 			if hasCycle {
 				return nil, true
 			}
@@ -134,7 +475,133 @@ func (g *Graph) TopoSort() ([]int, bool) {
 	return result, false
 }
 
-func (g *Graph) topoSort(vertex int, visited *map[int]bool, stack *Stack, recentlyVisited *map[int]bool) bool {
+// TopoSort is a shorthand for TopoSort(g)
+func (g *Graph) TopoSort() ([]int, bool) {
+	return TopoSort(g)
+}
+
+// TopoSortWithError is same as TopoSort, but reports the cycle (if any) as a *CycleError
+func TopoSortWithError(g GraphInterface) ([]int, error) {
+	if cycle, hasCycle := FindCycle(g); hasCycle {
+		return nil, &CycleError{Cycle: cycle}
+	}
+	result, _ := TopoSort(g)
+	return result, nil
+}
+
+// TopoSortWithError is a shorthand for TopoSortWithError(g)
+func (g *Graph) TopoSortWithError() ([]int, error) {
+	return TopoSortWithError(g)
+}
+
+// TopoSortLayers sorts the directed acyclic graph (DAG) into layers using Kahn's algo
+// Every vertex in a layer depends (i.e. has incoming edges) only on the vertices of the earlier layers,
+// so the vertices of a layer can be processed concurrently. The vertices in a layer are in ascending order.
+// It returns nil and true if the graph has a cycle.
+// Time Complexity: O(V + E)
+func TopoSortLayers(g GraphInterface) ([][]int, bool) {
+	// to hold the in-degrees of each vertex
+	inDegreeMap := inDegrees(g)
+	// to store the layers
+	result := [][]int{}
+	// number of vertices we put into the layers
+	sorted := 0
+
+	// the first layer is the vertices having in-degree == 0
+	layer := []int{}
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		if inDegreeMap[vertex] == 0 {
+			layer = append(layer, vertex)
+		}
+	}
+
+	for len(layer) > 0 {
+		result = append(result, layer)
+		sorted += len(layer)
+
+		// remove the layer from the graph, and collect the vertices whose in-degree became zero
+		next := []int{}
+		for _, vertex := range layer {
+			for _, neighbor := range g.Neighbors(vertex) {
+				inDegreeMap[neighbor]--
+				if inDegreeMap[neighbor] == 0 {
+					next = append(next, neighbor)
+				}
+			}
+		}
+		sort.Ints(next)
+		layer = next
+	}
+
+	// the vertices of a cycle never get their in-degree reduced to zero
+	if sorted != g.NumVertices() {
+		return nil, true
+	}
+	return result, false
+}
+
+// TopoSortLayers is a shorthand for TopoSortLayers(g)
+func (g *Graph) TopoSortLayers() ([][]int, bool) {
+	return TopoSortLayers(g)
+}
+
+// TopoSortLexicographic sorts the directed acyclic graph (DAG) into the lexicographically smallest topological order
+// i.e. out of all the vertices ready to be picked, it always picks the smallest one; so the order is deterministic.
+// It returns nil and true if the graph has a cycle.
+// Time Complexity: O(V log V + E)
+func TopoSortLexicographic(g GraphInterface) ([]int, bool) {
+	// to hold the in-degrees of each vertex
+	inDegreeMap := inDegrees(g)
+	// to store the topological ordered vertices
+	result := []int{}
+
+	// a min-heap of the vertices ready to be picked i.e. having in-degree == 0
+	ready := &heap.IntArray{}
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		if inDegreeMap[vertex] == 0 {
+			ready.Push(vertex)
+		}
+	}
+	heap.Build(ready)
+
+	for ready.Len() > 0 {
+		vertex := heap.DeleteTop(ready).(int)
+		result = append(result, vertex)
+
+		// remove the vertex from the graph
+		for _, neighbor := range g.Neighbors(vertex) {
+			inDegreeMap[neighbor]--
+			if inDegreeMap[neighbor] == 0 {
+				heap.Insert(ready, neighbor)
+			}
+		}
+	}
+
+	// the vertices of a cycle never get their in-degree reduced to zero
+	if len(result) != g.NumVertices() {
+		return nil, true
+	}
+	return result, false
+}
+
+// TopoSortLexicographic is a shorthand for TopoSortLexicographic(g)
+func (g *Graph) TopoSortLexicographic() ([]int, bool) {
+	return TopoSortLexicographic(g)
+}
+
+// inDegrees (private func) calculates the in-degree of each vertex
+func inDegrees(g GraphInterface) map[int]int {
+	inDegreeMap := map[int]int{}
+	for u := 0; u < g.NumVertices(); u++ {
+		neighbors := g.Neighbors(u)
+		for _, neighbor := range neighbors {
+			inDegreeMap[neighbor]++
+		}
+	}
+	return inDegreeMap
+}
+
+func topoSort(g GraphInterface, vertex int, visited *map[int]bool, stack *Stack, recentlyVisited *map[int]bool) bool {
 	// if the given vertex has already been visited in the ongoing call stack
 	// before backtracking
 	if (*recentlyVisited)[vertex] {
@@ -153,39 +620,45 @@ func (g *Graph) topoSort(vertex int, visited *map[int]bool, stack *Stack, recent
 	(*recentlyVisited)[vertex] = true
 
 	// iterate through all the adjacent vertices of the given vertex
-	for _, u := range g.AdjacencyList[vertex] {
+	for _, u := range g.Neighbors(vertex) {
 
 		// and run Topo Sort (a little tweaked DFS - with a Stack) for the adjacent vertex
-		hasCycle := g.topoSort(u, visited, stack, recentlyVisited)
+		hasCycle := topoSort(g, u, visited, stack, recentlyVisited)
 		if hasCycle {
 			return true
 		}
 	}
-	*recentlyVisited = map[int]bool{}
+	// backtrack: the vertex is no more in the ongoing call stack
+	delete(*recentlyVisited, vertex)
 
 	// push the vertex into the stack
 	stack.Push(vertex)
 	return false
 }
 
-func (g *Graph) IsCyclic() bool {
+func IsCyclic(g GraphInterface) bool {
 	// a memory map to flag the visited vertices
 	visited := map[int]bool{}
 	// a map to track all the vertices we visited before a backtrack
 	recentlyVisited := map[int]bool{}
-	for vertex := range g.AdjacencyList {
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
 		// as this is a directed graph (and may be disconnected as well)
 		// there could be possibilities that a few vertices remain unreachable
 		// so in such case, iterate over all the vertices
-		isCyclic := g.isCyclic(vertex, &visited, &recentlyVisited)
-		if isCyclic {
+		hasCycle := isCyclic(g, vertex, &visited, &recentlyVisited)
+		if hasCycle {
 			return true
 		}
 	}
 	return false
 }
 
-func (g *Graph) isCyclic(vertex int, visited *map[int]bool, recentlyVisited *map[int]bool) bool {
+// IsCyclic is a shorthand for IsCyclic(g)
+func (g *Graph) IsCyclic() bool {
+	return IsCyclic(g)
+}
+
+func isCyclic(g GraphInterface, vertex int, visited *map[int]bool, recentlyVisited *map[int]bool) bool {
 	// if the given vertex has already been visited in __current call stack__
 	if (*recentlyVisited)[vertex] {
 		// then there is a loop
@@ -201,68 +674,129 @@ func (g *Graph) isCyclic(vertex int, visited *map[int]bool, recentlyVisited *map
 	(*recentlyVisited)[vertex] = true
 
 	// iterate through all the adjacent vertices of the given vertex
-	for _, u := range g.AdjacencyList[vertex] {
+	for _, u := range g.Neighbors(vertex) {
 		// and run DFS for the adjacent vertex
-		isCyclic := g.isCyclic(u, visited, recentlyVisited)
-		if isCyclic {
+		hasCycle := isCyclic(g, u, visited, recentlyVisited)
+		if hasCycle {
 			return true
 		}
 	}
-	*recentlyVisited = map[int]bool{}
+	// backtrack: the vertex is no more in the ongoing call stack
+	delete(*recentlyVisited, vertex)
 	return false
 }
 
-// IsCyclic_V2 detects cycle in a directed graph using BFS by maintaing 3 colors of each node
-func (g *Graph) IsCyclic_V2() bool {
+// FindCycle finds a cycle in the directed graph using DFS
+// It returns the vertices of the cycle in the order of its edges (the first vertex is not repeated at the end),
+// and whether a cycle exists; e.g. [0 1 2] denotes the cycle 0 -> 1 -> 2 -> 0.
+func FindCycle(g GraphInterface) ([]int, bool) {
 	// a memory map to flag the visited vertices
 	visited := map[int]bool{}
-	for vertex := range g.AdjacencyList {
+	// the vertices in the ongoing call stack, in the order they were visited
+	path := []int{}
+	// position of the vertices in the path, to slice the cycle out of it
+	onPath := map[int]int{}
+
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		// as this is a directed graph (and may be disconnected as well)
+		// there could be possibilities that a few vertices remain unreachable
+		// so in such case, iterate over all the vertices
 		if visited[vertex] {
 			continue
 		}
+		if cycle := findCycle(g, vertex, visited, &path, onPath); cycle != nil {
+			return cycle, true
+		}
+	}
+	return nil, false
+}
+
+// FindCycle is a shorthand for FindCycle(g)
+func (g *Graph) FindCycle() ([]int, bool) {
+	return FindCycle(g)
+}
+
+func findCycle(g GraphInterface, vertex int, visited map[int]bool, path *[]int, onPath map[int]int) []int {
+	// mark the given vertex as visited & push it on the path
+	visited[vertex] = true
+	onPath[vertex] = len(*path)
+	*path = append(*path, vertex)
+
+	// iterate through all the adjacent vertices of the given vertex
+	for _, u := range g.Neighbors(vertex) {
+		// if the adjacent vertex is in the ongoing call stack, then the path from it till here is a cycle
+		if pos, found := onPath[u]; found {
+			return append([]int{}, (*path)[pos:]...)
+		}
+		if visited[u] {
+			continue
+		}
+		if cycle := findCycle(g, u, visited, path, onPath); cycle != nil {
+			return cycle
+		}
+	}
+
+	// backtrack: pop the vertex from the path
+	delete(onPath, vertex)
+	*path = (*path)[:len(*path)-1]
+	return nil
+}
+
+// IsCyclic_V2 detects cycle in a directed graph using iterative DFS (i.e. an explicit stack) by maintaing 3 colors of each node
+// Idea: a vertex is white till discovered, gray while its descendants are being explored & black once finished; an edge
+// to a gray vertex leads back into the ongoing path, so there is a cycle. An edge to a black vertex (e.g. a cross edge)
+// does not.
+func IsCyclic_V2(g GraphInterface) bool {
+	// to hold the color of each vertex (white, if absent)
+	colors := map[int]int{}
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		if colors[vertex] != white {
+			continue
+		}
 		// as this is a directed graph (and may be disconnected as well)
 		// there could be possibilities that a few vertices remain unreachable
 		// so in such case, iterate over all the vertices
-		isCyclic := g.isCyclic_V2(vertex, &visited)
-		if isCyclic {
+		hasCycle := isCyclic_V2(g, vertex, colors)
+		if hasCycle {
 			return true
 		}
 	}
 	return false
 }
 
-func (g *Graph) isCyclic_V2(vertex int, visited *map[int]bool) bool {
-	// a memory map to flag the visited vertices
-	colors := map[int]int{}
-
-	q := NewQueue()
-	q.Enqueue(vertex)
-
-	colors[vertex] = 0
-	(*visited)[vertex] = true
+// IsCyclic_V2 is a shorthand for IsCyclic_V2(g)
+func (g *Graph) IsCyclic_V2() bool {
+	return IsCyclic_V2(g)
+}
 
-	for {
-		if q.IsEmpty() {
-			break
+func isCyclic_V2(g GraphInterface, vertex int, colors map[int]int) bool {
+	// a vertex in the ongoing path, with its neighbors & the index of the next one to explore
+	// (the neighbors are fetched once per vertex, as Neighbors may take O(V) time e.g. of an AdjacencyMatrix)
+	type frame struct {
+		vertex    int
+		neighbors []int
+		next      int
+	}
+	stack := []frame{{vertex: vertex, neighbors: g.Neighbors(vertex)}}
+	colors[vertex] = gray
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.next == len(top.neighbors) {
+			// all the descendants are explored, so the vertex is no more in the ongoing path
+			colors[top.vertex] = black
+			stack = stack[:len(stack)-1]
+			continue
 		}
+		neighbor := top.neighbors[top.next]
+		top.next++
 
-		n, err := q.Dequeue()
-		if err != nil {
-			panic(err)
-		}
-		node := n.(int)
-		colors[node] = -1
-		(*visited)[vertex] = true
-
-		for _, neighbor := range g.AdjacencyList[node] {
-			if colors[neighbor] == -1 {
-				return true
-			}
-			if (*visited)[neighbor] {
-				continue
-			}
-			q.Enqueue(neighbor)
-			colors[neighbor] = 0
+		switch colors[neighbor] {
+		case gray:
+			return true
+		case white:
+			colors[neighbor] = gray
+			stack = append(stack, frame{vertex: neighbor, neighbors: g.Neighbors(neighbor)})
 		}
 	}
 	return false
@@ -270,19 +804,17 @@ func (g *Graph) isCyclic_V2(vertex int, visited *map[int]bool) bool {
 
 // IsCyclic_V3 detects cycle in a directed graph using BFS by manupulating (reducing) in-degree of the node
 // Idea: If there exists a cycle, then the vertices involved in the cycle would have in-degree greater than zero. So, if we remove all the vertices having in-degree == 0 in the graph and find that the graph is left with all the vertices having in-degree > 0; we can conclude the graph have a cycle.
-func (g *Graph) IsCyclic_V3() bool {
+func IsCyclic_V3(g GraphInterface) bool {
+	// an empty graph has no cycle (else it would be declared cyclic below, as no vertex gets removed)
+	if g.NumVertices() == 0 {
+		return false
+	}
+
 	// to hold the visited vertices
 	visited := map[int]bool{}
 
 	// to hold the in-degrees of each vertex
-	inDegreeMap := map[int]int{}
-
-	// calculate in-degrees
-	for _, neighbors := range g.AdjacencyList {
-		for _, neighbor := range neighbors {
-			inDegreeMap[neighbor]++
-		}
-	}
+	inDegreeMap := inDegrees(g)
 
 	// pre-check
 	// if in-degree of all the vertices are > 0 then declare the graph cyclic
@@ -299,20 +831,25 @@ func (g *Graph) IsCyclic_V3() bool {
 	// as this is a directed graph (and may be disconnected as well)
 	// there could be possibilities that a few vertices remain unreachable
 	// so in such case, iterate over all the vertices
-	for vertex := range g.AdjacencyList {
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
 		// but run the check for only those vertices which are un-visited and have in-degree == 0
 		if visited[vertex] || inDegreeMap[vertex] != 0 {
 			continue
 		}
-		g.isCyclic_V3(vertex, visited, &removed, inDegreeMap)
-		if removed == len(g.AdjacencyList) {
+		isCyclic_V3(g, vertex, visited, &removed, inDegreeMap)
+		if removed == g.NumVertices() {
 			return false
 		}
 	}
 	return true
 }
 
-func (g *Graph) isCyclic_V3(vertex int, visited map[int]bool, removed *int, inDegreeMap map[int]int) {
+// IsCyclic_V3 is a shorthand for IsCyclic_V3(g)
+func (g *Graph) IsCyclic_V3() bool {
+	return IsCyclic_V3(g)
+}
+
+func isCyclic_V3(g GraphInterface, vertex int, visited map[int]bool, removed *int, inDegreeMap map[int]int) {
 	q := NewQueue()
 	q.Enqueue(vertex)
 
@@ -330,7 +867,7 @@ func (g *Graph) isCyclic_V3(vertex int, visited map[int]bool, removed *int, inDe
 		// as we removed this vertex from the graph, increase the counter
 		(*removed)++
 
-		for _, neighbor := range g.AdjacencyList[node] {
+		for _, neighbor := range g.Neighbors(node) {
 			// as we removed the parent of this vertex, decrease its in-degree by 1
 			inDegreeMap[neighbor]--
 			inDegree := inDegreeMap[neighbor]
@@ -342,42 +879,3 @@ func (g *Graph) isCyclic_V3(vertex int, visited map[int]bool, removed *int, inDe
 		}
 	}
 }
-
-/* TODO - Better design
-
-type Graph interface{}
-type GraphNode interface{}
-
-func (g *Graph) AddEdge(u GraphNode, v GraphNode, weight int)
-
-NewSimpleGraph(implType=it.AdjacencyList) == NewGraph(implType=it.AdjacencyList)
-NewGraph(implType=it.AdjacencyList)
-
-// DGNode
-type DGNode struct {
-}
-
-// DGraph denotes a Directed Graph data structure
-type Graph struct {
-	Vertices      int
-	AdjacencyList [][]int
-}
-
-// NewGraph creates & returns a Directed Graph implemented using Adjacency List
-func NewGraph(numOfVertices int) *Graph {
-	// create graph struct
-	graph := &Graph{Vertices: numOfVertices, AdjacencyList: make([][]int, numOfVertices)}
-
-	// init graph.AdjacencyMatrix with Zeros of [numOfVertices X numOfVertices] matrix
-	for idx := range graph.AdjacencyList {
-		// graph.AdjacencyList[idx] = make([]int, numOfVertices)
-		graph.AdjacencyList[idx] = []int{}
-	}
-	return graph
-}
-
-// AddEdge inserts edge to the directed graph
-func (g *Graph) AddEdge(u int, v int) {
-	g.AdjacencyList[u] = append(g.AdjacencyList[u], v)
-}
-*/
@@ -8,6 +8,7 @@ Distributed under terms of the MIT license.
 package adt
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -81,6 +82,95 @@ func TestGraph_TopoSort(t *testing.T) {
 	assert.False(t, hasCycle)
 	assert.Equal(t, []int{5, 4, 2, 3, 1, 0}, result)
 
+	// a cycle reachable only after backtracking from a sibling
+	g = NewGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(2, 0)
+	result, hasCycle = g.TopoSort()
+	assert.True(t, hasCycle)
+	assert.Nil(t, result)
+}
+
+func TestGraph_TopoSortWithError(t *testing.T) {
+	g := NewGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(0, 3)
+	result, err := g.TopoSortWithError()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 3, 1, 2}, result)
+
+	g.AddEdge(2, 0)
+	result, err = g.TopoSortWithError()
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, ERR_GRAPH_HAS_CYCLE))
+
+	var cycleErr *CycleError
+	assert.True(t, errors.As(err, &cycleErr))
+	assert.Equal(t, []int{0, 1, 2}, cycleErr.Cycle)
+	assert.Equal(t, "graph has a cycle: [0 1 2]", err.Error())
+}
+
+func TestGraph_TopoSortLayers(t *testing.T) {
+	g := NewGraph(6)
+	g.AddEdge(5, 2)
+	g.AddEdge(5, 0)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(4, 0)
+	g.AddEdge(4, 1)
+	result, hasCycle := g.TopoSortLayers()
+	assert.False(t, hasCycle)
+	assert.Equal(t, [][]int{{4, 5}, {0, 2}, {3}, {1}}, result)
+
+	g.AddEdge(1, 5)
+	result, hasCycle = g.TopoSortLayers()
+	assert.True(t, hasCycle)
+	assert.Nil(t, result)
+}
+
+func TestGraph_TopoSortLexicographic(t *testing.T) {
+	g := NewGraph(6)
+	g.AddEdge(5, 2)
+	g.AddEdge(5, 0)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(4, 0)
+	g.AddEdge(4, 1)
+	result, hasCycle := g.TopoSortLexicographic()
+	assert.False(t, hasCycle)
+	assert.Equal(t, []int{4, 5, 0, 2, 3, 1}, result)
+
+	g.AddEdge(1, 5)
+	result, hasCycle = g.TopoSortLexicographic()
+	assert.True(t, hasCycle)
+	assert.Nil(t, result)
+}
+
+func TestGraph_FindCycle(t *testing.T) {
+	g := NewGraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(0, 2)
+	g.AddEdge(3, 4)
+	cycle, hasCycle := g.FindCycle()
+	assert.False(t, hasCycle)
+	assert.Nil(t, cycle)
+
+	g.AddEdge(4, 2)
+	g.AddEdge(2, 3)
+	cycle, hasCycle = g.FindCycle()
+	assert.True(t, hasCycle)
+	assert.Equal(t, []int{2, 3, 4}, cycle)
+
+	// self loop
+	g = NewGraph(2)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 1)
+	cycle, hasCycle = g.FindCycle()
+	assert.True(t, hasCycle)
+	assert.Equal(t, []int{1}, cycle)
 }
 
 func TestGraph_IsCyclic(t *testing.T) {
@@ -139,6 +229,23 @@ func TestGraph_IsCyclic(t *testing.T) {
 	g4.AddEdge(1, 2)
 	g4.AddEdge(2, 0)
 
+	/*
+		0 --> 1
+		|
+		v
+		2 --> 0
+	*/
+	g5 := NewGraph(3)
+	g5.AddEdge(0, 1)
+	g5.AddEdge(0, 2)
+	g5.AddEdge(2, 0)
+
+	// a DAG having a cross edge 2 -> 1 into an already visited vertex
+	g6 := NewGraph(3)
+	g6.AddEdge(0, 1)
+	g6.AddEdge(0, 2)
+	g6.AddEdge(2, 1)
+
 	testcases := []struct {
 		given *Graph
 		want  bool
@@ -147,6 +254,9 @@ func TestGraph_IsCyclic(t *testing.T) {
 		{g2, false},
 		{g3, true},
 		{g4, true},
+		{g5, true},
+		{g6, false},
+		{NewGraph(0), false},
 	}
 
 	for _, tc := range testcases {
@@ -168,3 +278,92 @@ func TestGraph_IsCyclic(t *testing.T) {
 
 	}
 }
+
+// neighborsCountingGraph counts the calls of Neighbors on the underlying graph
+type neighborsCountingGraph struct {
+	GraphInterface
+	calls int
+}
+
+func (g *neighborsCountingGraph) Neighbors(vertex int) []int {
+	g.calls++
+	return g.GraphInterface.Neighbors(vertex)
+}
+
+func TestIsCyclic_V2_FetchesNeighborsOncePerVertex(t *testing.T) {
+	// a dense DAG: u -> v for every u < v
+	m := NewAdjacencyMatrix(20)
+	for u := 0; u < 20; u++ {
+		for v := u + 1; v < 20; v++ {
+			m.AddEdge(u, v)
+		}
+	}
+	g := &neighborsCountingGraph{GraphInterface: m}
+	assert.False(t, IsCyclic_V2(g))
+	assert.Equal(t, 20, g.calls)
+}
+
+func TestGraph_RemoveEdge(t *testing.T) {
+	g := NewGraph(3)
+	g.AddWeightedEdge(0, 1, 2)
+	g.AddWeightedEdge(0, 2, 3)
+	g.AddWeightedEdge(0, 1, 4)
+
+	assert.True(t, g.HasEdge(0, 1))
+	// all the parallel edges are removed, keeping the weights aligned
+	assert.True(t, g.RemoveEdge(0, 1))
+	assert.False(t, g.HasEdge(0, 1))
+	assert.Equal(t, []int{2}, g.Neighbors(0))
+	assert.Equal(t, []float64{3}, g.EdgeWeights(0))
+	assert.False(t, g.RemoveEdge(0, 1))
+
+	// an edge appended directly to AdjacencyList is of weight 1
+	g.AdjacencyList[1] = append(g.AdjacencyList[1], 2)
+	assert.Equal(t, []float64{1}, g.EdgeWeights(1))
+}
+
+func TestGraph_AddVertex(t *testing.T) {
+	g := NewGraph(0)
+	assert.Equal(t, 0, g.AddVertex())
+	assert.Equal(t, 1, g.AddVertex())
+	g.AddWeightedEdge(0, 1, 2)
+	assert.Equal(t, 2, g.Vertices)
+	assert.Equal(t, 2, g.NumVertices())
+	assert.True(t, g.HasEdge(0, 1))
+}
+
+func TestGraph_RemoveVertex(t *testing.T) {
+	g := NewGraph(4)
+	g.AddWeightedEdge(0, 1, 1)
+	g.AddWeightedEdge(0, 3, 2)
+	g.AddWeightedEdge(1, 2, 3)
+	g.AddWeightedEdge(2, 3, 4)
+	g.AddWeightedEdge(3, 1, 5)
+	g.AddWeightedEdge(3, 0, 6)
+
+	assert.Equal(t, 2, g.OutDegree(3))
+	assert.Equal(t, 2, g.InDegree(1))
+	assert.Equal(t, 0, g.InDegree(4))
+
+	// removing 1 drops 0 -> 1, 1 -> 2 & 3 -> 1; and renumbers 2, 3 to 1, 2
+	g.RemoveVertex(1)
+	assert.Equal(t, 3, g.Vertices)
+	assert.Equal(t, [][]int{{2}, {2}, {0}}, g.AdjacencyList)
+	assert.Equal(t, [][]float64{{2}, {4}, {6}}, g.Weights)
+	assert.Equal(t, 2, g.InDegree(2))
+	assert.Equal(t, 1, g.OutDegree(0))
+
+	assert.PanicsWithValue(t, ERR_VERTEX_OUT_OF_RANGE, func() { g.RemoveVertex(3) })
+}
+
+func TestGraph_Transpose(t *testing.T) {
+	g := NewGraph(3)
+	g.AddWeightedEdge(0, 1, 2)
+	g.AddWeightedEdge(0, 2, 3)
+	g.AddWeightedEdge(2, 1, 4)
+
+	transpose := g.Transpose()
+	assert.Equal(t, [][]int{{}, {0, 2}, {0}}, transpose.AdjacencyList)
+	assert.Equal(t, [][]float64{{}, {2, 4}, {3}}, transpose.Weights)
+	assert.Equal(t, g, transpose.Transpose())
+}
@@ -63,6 +63,10 @@ func DeleteTop(arr Interface) interface{} {
 
 	// replace the top node with the last leaf
 	poppedLastLeaf := arr.Pop()
+	// if the top node was the last leaf itself, then the heap is empty now
+	if arr.Len() == 0 {
+		return top
+	}
 	arr.Set(0, poppedLastLeaf)
 
 	// percolateDown the new root node
@@ -67,3 +67,11 @@ func TestHeap(t *testing.T) {
 	assert.Equal(t, 0, Top(arr))
 	assert.Equal(t, 5, arr.ItemAt(5))
 }
+
+func TestHeap_DeleteTop_LastNode(t *testing.T) {
+	arr := &IntArray{}
+
+	Insert(arr, 1)
+	assert.Equal(t, 1, DeleteTop(arr))
+	assert.Equal(t, 0, arr.Len())
+}
@@ -0,0 +1,170 @@
+/*
+keyed.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements Graphs whose vertices are arbitrary keys (instead of the dense integer indices)
+
+package adt
+
+// GraphNode denotes a vertex of a KeyedGraph
+// It can be any comparable value (i.e. usable as a map key) e.g. a string, an int or a struct of such fields.
+type GraphNode interface{}
+
+// KeyedGraph denotes a Directed Graph whose vertices are GraphNode keys, e.g. package names or service IDs
+// The graph grows as the vertices & edges are added. It is backed by a Graph, where each node is mapped to
+// an integer vertex (in the order the nodes were added); so all the algorithms of Graph can be run on Dense().
+type KeyedGraph struct {
+	graph *Graph
+	// the vertex of each node
+	ids map[GraphNode]int
+	// the node of each vertex
+	nodes []GraphNode
+}
+
+// NewKeyedGraph creates & returns an empty Directed Graph keyed by GraphNode
+func NewKeyedGraph() *KeyedGraph {
+	return &KeyedGraph{graph: NewGraph(0), ids: map[GraphNode]int{}, nodes: []GraphNode{}}
+}
+
+// AddVertex inserts the node to the graph, if not present already, and returns its vertex in the Dense() graph
+// It panics if the node is not comparable.
+func (g *KeyedGraph) AddVertex(node GraphNode) int {
+	if id, found := g.ids[node]; found {
+		return id
+	}
+	id := g.graph.AddVertex()
+	g.ids[node] = id
+	g.nodes = append(g.nodes, node)
+	return id
+}
+
+// AddEdge inserts edge to the directed graph, adding the nodes if not present already
+func (g *KeyedGraph) AddEdge(u GraphNode, v GraphNode) {
+	g.AddWeightedEdge(u, v, 1)
+}
+
+// AddWeightedEdge inserts an edge of the given weight (aka cost) to the directed graph, adding the nodes if not present already
+func (g *KeyedGraph) AddWeightedEdge(u GraphNode, v GraphNode, weight float64) {
+	g.graph.AddWeightedEdge(g.AddVertex(u), g.AddVertex(v), weight)
+}
+
+// RemoveVertex deletes the node along with all of its outgoing & incoming edges, and tells whether it was present
+// The vertices of the nodes added after it are renumbered to one less in the Dense() graph.
+func (g *KeyedGraph) RemoveVertex(node GraphNode) bool {
+	id, found := g.ids[node]
+	if !found {
+		return false
+	}
+	g.graph.RemoveVertex(id)
+	delete(g.ids, node)
+	g.nodes = append(g.nodes[:id], g.nodes[id+1:]...)
+	for idx := id; idx < len(g.nodes); idx++ {
+		g.ids[g.nodes[idx]] = idx
+	}
+	return true
+}
+
+// RemoveEdge deletes the edge u -> v (all of them, in case of parallel edges), and tells whether it existed
+func (g *KeyedGraph) RemoveEdge(u GraphNode, v GraphNode) bool {
+	uid, uFound := g.ids[u]
+	vid, vFound := g.ids[v]
+	if !uFound || !vFound {
+		return false
+	}
+	return g.graph.RemoveEdge(uid, vid)
+}
+
+// HasEdge tells whether the edge u -> v exists
+func (g *KeyedGraph) HasEdge(u GraphNode, v GraphNode) bool {
+	uid, uFound := g.ids[u]
+	vid, vFound := g.ids[v]
+	return uFound && vFound && g.graph.HasEdge(uid, vid)
+}
+
+// HasVertex tells whether the node is present in the graph
+func (g *KeyedGraph) HasVertex(node GraphNode) bool {
+	_, found := g.ids[node]
+	return found
+}
+
+// ID returns the vertex of the node in the Dense() graph, and whether the node is present
+func (g *KeyedGraph) ID(node GraphNode) (int, bool) {
+	id, found := g.ids[node]
+	return id, found
+}
+
+// Node returns the node of the vertex of the Dense() graph
+func (g *KeyedGraph) Node(id int) GraphNode {
+	return g.nodes[id]
+}
+
+// Nodes returns all the nodes, in the order they were added
+func (g *KeyedGraph) Nodes() []GraphNode {
+	return append([]GraphNode{}, g.nodes...)
+}
+
+// Neighbors returns the nodes adjacent to the node i.e. the heads of its outgoing edges
+func (g *KeyedGraph) Neighbors(node GraphNode) []GraphNode {
+	id, found := g.ids[node]
+	if !found {
+		return nil
+	}
+	return g.toNodes(g.graph.AdjacencyList[id])
+}
+
+// Dense returns the backing Graph, whose vertex i is the node Node(i)
+func (g *KeyedGraph) Dense() *Graph {
+	return g.graph
+}
+
+// DFS traverse the graph in Depth First Order and returns the nodes in the order
+func (g *KeyedGraph) DFS() []GraphNode {
+	return g.toNodes(g.graph.DFS())
+}
+
+// TopoSort sorts the directed acyclic graph (DAG) into Topological order
+// A topological ordering is possible if and only if the graph has no directed cycles
+func (g *KeyedGraph) TopoSort() ([]GraphNode, bool) {
+	result, hasCycle := g.graph.TopoSort()
+	if hasCycle {
+		return nil, true
+	}
+	return g.toNodes(result), false
+}
+
+// FindCycle finds a cycle in the directed graph
+// It returns the nodes of the cycle in the order of its edges, and whether a cycle exists.
+func (g *KeyedGraph) FindCycle() ([]GraphNode, bool) {
+	cycle, hasCycle := g.graph.FindCycle()
+	if !hasCycle {
+		return nil, false
+	}
+	return g.toNodes(cycle), true
+}
+
+// IsCyclic detects cycle in the directed graph using DFS
+func (g *KeyedGraph) IsCyclic() bool {
+	return g.graph.IsCyclic()
+}
+
+// IsCyclic_V2 detects cycle in the directed graph using BFS by maintaing 3 colors of each node
+func (g *KeyedGraph) IsCyclic_V2() bool {
+	return g.graph.IsCyclic_V2()
+}
+
+// IsCyclic_V3 detects cycle in the directed graph using BFS by manupulating (reducing) in-degree of the node
+func (g *KeyedGraph) IsCyclic_V3() bool {
+	return g.graph.IsCyclic_V3()
+}
+
+// toNodes (private func) maps the vertices of the Dense() graph to their nodes
+func (g *KeyedGraph) toNodes(ids []int) []GraphNode {
+	nodes := make([]GraphNode, len(ids))
+	for idx, id := range ids {
+		nodes[idx] = g.nodes[id]
+	}
+	return nodes
+}
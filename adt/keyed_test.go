@@ -0,0 +1,118 @@
+/*
+keyed_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyedGraph(t *testing.T) {
+	g := NewKeyedGraph()
+	// an empty graph
+	assert.False(t, g.IsCyclic())
+	assert.False(t, g.IsCyclic_V2())
+	assert.False(t, g.IsCyclic_V3())
+
+	g.AddEdge("app", "lib")
+	g.AddEdge("lib", "core")
+	g.AddEdge("app", "core")
+	g.AddVertex("tool")
+
+	assert.Equal(t, []GraphNode{"app", "lib", "core", "tool"}, g.Nodes())
+	assert.True(t, g.HasVertex("core"))
+	assert.False(t, g.HasVertex("missing"))
+	assert.Equal(t, []GraphNode{"lib", "core"}, g.Neighbors("app"))
+	assert.Nil(t, g.Neighbors("missing"))
+
+	id, ok := g.ID("core")
+	assert.True(t, ok)
+	assert.Equal(t, 2, id)
+	assert.Equal(t, "core", g.Node(id))
+	assert.Equal(t, 4, g.Dense().Vertices)
+
+	assert.Equal(t, []GraphNode{"app", "lib", "core", "tool"}, g.DFS())
+
+	result, hasCycle := g.TopoSort()
+	assert.False(t, hasCycle)
+	assert.Equal(t, []GraphNode{"tool", "app", "lib", "core"}, result)
+	assert.False(t, g.IsCyclic())
+	assert.False(t, g.IsCyclic_V2())
+	assert.False(t, g.IsCyclic_V3())
+
+	g.AddEdge("core", "app")
+	result, hasCycle = g.TopoSort()
+	assert.True(t, hasCycle)
+	assert.Nil(t, result)
+	assert.True(t, g.IsCyclic())
+	assert.True(t, g.IsCyclic_V2())
+	assert.True(t, g.IsCyclic_V3())
+
+	cycle, hasCycle := g.FindCycle()
+	assert.True(t, hasCycle)
+	assert.Equal(t, []GraphNode{"app", "lib", "core"}, cycle)
+
+	// a DAG having a cross edge "b" -> "a" into an already visited vertex
+	g = NewKeyedGraph()
+	g.AddEdge("root", "a")
+	g.AddEdge("root", "b")
+	g.AddEdge("b", "a")
+
+	assert.False(t, g.IsCyclic())
+	assert.False(t, g.IsCyclic_V2())
+	assert.False(t, g.IsCyclic_V3())
+
+	g.AddEdge("a", "root")
+	assert.True(t, g.IsCyclic())
+	assert.True(t, g.IsCyclic_V2())
+	assert.True(t, g.IsCyclic_V3())
+}
+
+func TestKeyedGraph_StructKeys(t *testing.T) {
+	type service struct {
+		Name   string
+		Region string
+	}
+	g := NewKeyedGraph()
+	api := service{"api", "eu"}
+	db := service{"db", "eu"}
+	g.AddWeightedEdge(api, db, 2.5)
+	// an equal struct value is the same node
+	g.AddEdge(service{"api", "eu"}, service{"cache", "eu"})
+
+	assert.Len(t, g.Nodes(), 3)
+	weight, ok := g.Dense().EdgeWeight(g.AddVertex(api), g.AddVertex(db))
+	assert.True(t, ok)
+	assert.Equal(t, 2.5, weight)
+}
+
+func TestKeyedGraph_RemoveVertex(t *testing.T) {
+	g := NewKeyedGraph()
+	g.AddEdge("app", "lib")
+	g.AddEdge("lib", "core")
+	g.AddEdge("app", "core")
+
+	assert.True(t, g.HasEdge("app", "lib"))
+	assert.False(t, g.HasEdge("lib", "app"))
+	assert.False(t, g.HasEdge("app", "missing"))
+
+	assert.True(t, g.RemoveVertex("lib"))
+	assert.False(t, g.RemoveVertex("lib"))
+	assert.Equal(t, []GraphNode{"app", "core"}, g.Nodes())
+	assert.Equal(t, []GraphNode{"core"}, g.Neighbors("app"))
+	id, ok := g.ID("core")
+	assert.True(t, ok)
+	assert.Equal(t, 1, id)
+	assert.Equal(t, "core", g.Node(id))
+
+	assert.True(t, g.RemoveEdge("app", "core"))
+	assert.False(t, g.RemoveEdge("app", "core"))
+	assert.False(t, g.RemoveEdge("app", "missing"))
+	assert.Equal(t, []GraphNode{}, g.Neighbors("app"))
+}
@@ -0,0 +1,164 @@
+/*
+kshortest.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements the K Shortest Paths of Graphs
+
+package adt
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/toransahu/goutils/adt/heap"
+)
+
+// KShortestPaths finds the k shortest (least weight) loopless paths from the source to the target vertex, using Yen's algo
+// It returns the paths (each one as its vertices, from the source to the target) in increasing order of their cost,
+// along with their costs. Fewer than k paths are returned, if there aren't as many.
+// The paths are distinct as the sequences of vertices; of the parallel edges, the lightest one is taken.
+// Like Dijkstra, it does not work with negative edge weights.
+// Time Complexity: O(k * V * (V + E) log V)
+func KShortestPaths(g GraphInterface, source int, target int, k int) ([][]int, []float64, error) {
+	if source < 0 || source >= g.NumVertices() || target < 0 || target >= g.NumVertices() {
+		return nil, nil, ERR_VERTEX_OUT_OF_RANGE
+	}
+	if hasNegativeEdge(g) {
+		return nil, nil, ERR_NEGATIVE_EDGE_WEIGHT
+	}
+	paths, costs := [][]int{}, []float64{}
+	if k <= 0 {
+		return paths, costs, nil
+	}
+
+	// the shortest path
+	distances, predecessors := restrictedDijkstra(g, source, target, nil)
+	if math.IsInf(distances[target], 1) {
+		return paths, costs, nil
+	}
+	paths = append(paths, PathTo(predecessors, source, target))
+	costs = append(costs, distances[target])
+
+	// a min-heap of the candidate paths, used as a priority queue
+	candidates := &candidatePathArray{}
+	// a memory map to flag the paths found already (either as a candidate or as one of the k paths)
+	seen := map[string]bool{pathKey(paths[0]): true}
+
+	for len(paths) < k {
+		previous := paths[len(paths)-1]
+		// the cost of each prefix of the previous path i.e. rootCosts[j] is the cost up to previous[j]
+		rootCosts := make([]float64, len(previous))
+		for j := 1; j < len(previous); j++ {
+			rootCosts[j] = rootCosts[j-1] + lightestWeight(g, previous[j-1], previous[j])
+		}
+
+		// deviate from the previous path at each of its vertices (the spur vertex)
+		for j := 0; j < len(previous)-1; j++ {
+			spur, root := previous[j], previous[:j+1]
+
+			// remove the edges leaving the spur vertex along the found paths sharing the same root,
+			// so the spur path deviates from all of them
+			removedEdges := map[[2]int]bool{}
+			for _, path := range paths {
+				if len(path) > j+1 && samePath(path[:j+1], root) {
+					removedEdges[[2]int{path[j], path[j+1]}] = true
+				}
+			}
+			// remove the vertices of the root (except the spur vertex), so the path remains loopless
+			removedVertices := map[int]bool{}
+			for _, vertex := range root[:j] {
+				removedVertices[vertex] = true
+			}
+
+			distances, predecessors := restrictedDijkstra(g, spur, target, func(u int, v int) bool {
+				return removedVertices[v] || removedEdges[[2]int{u, v}]
+			})
+			if math.IsInf(distances[target], 1) {
+				continue
+			}
+			path := append(append([]int{}, root[:j]...), PathTo(predecessors, spur, target)...)
+			if key := pathKey(path); !seen[key] {
+				seen[key] = true
+				heap.Insert(candidates, candidatePath{path: path, cost: rootCosts[j] + distances[target]})
+			}
+		}
+
+		if candidates.Len() == 0 {
+			break
+		}
+		best := heap.DeleteTop(candidates).(candidatePath)
+		paths = append(paths, best.path)
+		costs = append(costs, best.cost)
+	}
+	return paths, costs, nil
+}
+
+// KShortestPaths is a shorthand for KShortestPaths(g, source, target, k)
+func (g *Graph) KShortestPaths(source int, target int, k int) ([][]int, []float64, error) {
+	return KShortestPaths(g, source, target, k)
+}
+
+// lightestWeight (private func) returns the weight of the lightest edge u -> v, +Inf if there is none
+func lightestWeight(g GraphInterface, u int, v int) float64 {
+	lightest := math.Inf(1)
+	weights := g.EdgeWeights(u)
+	for idx, neighbor := range g.Neighbors(u) {
+		if neighbor == v && weights[idx] < lightest {
+			lightest = weights[idx]
+		}
+	}
+	return lightest
+}
+
+// samePath (private func) tells whether the paths have the same vertices in the same order
+func samePath(a []int, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for idx := range a {
+		if a[idx] != b[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+// pathKey (private func) returns a string uniquely identifying the path, usable as a map key
+func pathKey(path []int) string {
+	return fmt.Sprint(path)
+}
+
+/*
+ HELPERS
+*/
+
+// candidatePath is an item of the priority queue of the candidate paths of Yen's algo
+type candidatePath struct {
+	path []int
+	cost float64
+}
+
+// candidatePathArray implements heap.Interface for candidatePath items
+// The paths of the same cost are ordered by their number of edges.
+type candidatePathArray []candidatePath
+
+func (a candidatePathArray) LessThan(i, j int) bool {
+	if a[i].cost != a[j].cost {
+		return a[i].cost < a[j].cost
+	}
+	return len(a[i].path) < len(a[j].path)
+}
+func (a candidatePathArray) Len() int                    { return len(a) }
+func (a candidatePathArray) Swap(i, j int)               { a[i], a[j] = a[j], a[i] }
+func (a candidatePathArray) ItemAt(i int) interface{}    { return a[i] }
+func (a candidatePathArray) Set(i int, item interface{}) { a[i] = item.(candidatePath) }
+func (a *candidatePathArray) Push(item interface{})      { *a = append(*a, item.(candidatePath)) }
+func (a *candidatePathArray) Pop() interface{} {
+	lastIndex := len(*a) - 1
+	popped := (*a)[lastIndex]
+	*a = (*a)[0:lastIndex]
+	return popped
+}
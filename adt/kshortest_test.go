@@ -0,0 +1,77 @@
+/*
+kshortest_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_KShortestPaths(t *testing.T) {
+	// the example of Yen's algo on Wikipedia, with C..H as 0..5
+	g := NewGraph(6)
+	g.AddWeightedEdge(0, 1, 3)
+	g.AddWeightedEdge(0, 2, 2)
+	g.AddWeightedEdge(1, 3, 4)
+	g.AddWeightedEdge(2, 1, 1)
+	g.AddWeightedEdge(2, 3, 2)
+	g.AddWeightedEdge(2, 4, 3)
+	g.AddWeightedEdge(3, 4, 2)
+	g.AddWeightedEdge(3, 5, 1)
+	g.AddWeightedEdge(4, 5, 2)
+
+	paths, costs, err := g.KShortestPaths(0, 5, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int{{0, 2, 3, 5}, {0, 2, 4, 5}, {0, 1, 3, 5}}, paths)
+	assert.Equal(t, []float64{5, 7, 8}, costs)
+
+	// all of the paths, in increasing order of cost
+	paths, costs, err = g.KShortestPaths(0, 5, 10)
+	assert.Nil(t, err)
+	assert.Len(t, paths, 7)
+	for idx := 1; idx < len(costs); idx++ {
+		assert.LessOrEqual(t, costs[idx-1], costs[idx])
+	}
+	seen := map[string]bool{}
+	for _, path := range paths {
+		assert.False(t, seen[pathKey(path)])
+		seen[pathKey(path)] = true
+	}
+
+	paths, costs, err = KShortestPaths(g, 5, 0, 3)
+	assert.Nil(t, err)
+	assert.Empty(t, paths)
+	assert.Empty(t, costs)
+
+	paths, _, err = g.KShortestPaths(0, 0, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int{{0}}, paths)
+
+	_, _, err = g.KShortestPaths(0, 6, 3)
+	assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err)
+	g.AddWeightedEdge(5, 0, -1)
+	_, _, err = g.KShortestPaths(0, 5, 3)
+	assert.Equal(t, ERR_NEGATIVE_EDGE_WEIGHT, err)
+}
+
+func TestGraph_KShortestPaths_Loopless(t *testing.T) {
+	// a cycle 0 <-> 1 must not be used to make more paths
+	g := NewGraph(3)
+	g.AddWeightedEdge(0, 1, 1)
+	g.AddWeightedEdge(1, 0, 1)
+	g.AddWeightedEdge(1, 2, 1)
+	g.AddWeightedEdge(0, 2, 5)
+	// a heavier parallel edge does not make another path
+	g.AddWeightedEdge(1, 2, 3)
+
+	paths, costs, err := g.KShortestPaths(0, 2, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int{{0, 1, 2}, {0, 2}}, paths)
+	assert.Equal(t, []float64{2, 5}, costs)
+}
@@ -0,0 +1,221 @@
+/*
+matching.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements Bipartite Matching & the Assignment Problem
+
+package adt
+
+import (
+	"math"
+
+	myerr "github.com/toransahu/goutils/errors"
+)
+
+var ERR_GRAPH_IS_NOT_BIPARTITE myerr.UserDefinedError = "graph is not bipartite"
+var ERR_INVALID_COST_MATRIX myerr.UserDefinedError = "cost matrix is not rectangular, or has a NaN or -Inf cost"
+var ERR_NO_FEASIBLE_ASSIGNMENT myerr.UserDefinedError = "no assignment avoids the forbidden (+Inf cost) pairs"
+
+// HopcroftKarp finds a maximum matching of the bipartite undirected graph using Hopcroft-Karp algo
+// i.e. the largest set of edges, where no two edges share a vertex.
+// It returns the vertex matched with each vertex (-1 if unmatched), and the number of matched edges.
+// Time Complexity: O(E.sqrt(V))
+func HopcroftKarp(g GraphInterface) ([]int, int, error) {
+	// split the vertices into the left (color 0) & the right (color 1) sides
+	colors, ok := IsBipartite(g)
+	if !ok {
+		return nil, 0, ERR_GRAPH_IS_NOT_BIPARTITE
+	}
+
+	mate := make([]int, g.NumVertices())
+	for vertex := range mate {
+		mate[vertex] = -1
+	}
+	// the distance of each left vertex in the layered graph of alternating paths
+	distance := make([]int, g.NumVertices())
+	size := 0
+
+	// bfs builds the layers of alternating paths starting at the unmatched left vertices
+	// It tells whether an augmenting path (i.e. one ending at an unmatched right vertex) exists.
+	bfs := func() bool {
+		q := NewQueue()
+		for vertex, color := range colors {
+			if color != 0 {
+				continue
+			}
+			if mate[vertex] == -1 {
+				distance[vertex] = 0
+				q.Enqueue(vertex)
+			} else {
+				distance[vertex] = -1
+			}
+		}
+		found := false
+		for !q.IsEmpty() {
+			n, err := q.Dequeue()
+			if err != nil {
+				panic(err)
+			}
+			left := n.(int)
+			for _, right := range g.Neighbors(left) {
+				next := mate[right]
+				if next == -1 {
+					found = true
+				} else if distance[next] == -1 {
+					distance[next] = distance[left] + 1
+					q.Enqueue(next)
+				}
+			}
+		}
+		return found
+	}
+
+	// dfs finds an augmenting path from the left vertex along the layers, and flips the matching along it
+	var dfs func(left int) bool
+	dfs = func(left int) bool {
+		for _, right := range g.Neighbors(left) {
+			next := mate[right]
+			if next == -1 || (distance[next] == distance[left]+1 && dfs(next)) {
+				mate[left] = right
+				mate[right] = left
+				return true
+			}
+		}
+		// a dead end; exclude it from the rest of this phase
+		distance[left] = -1
+		return false
+	}
+
+	// augment along a maximal set of shortest, vertex disjoint augmenting paths in each phase
+	for bfs() {
+		for vertex, color := range colors {
+			if color == 0 && mate[vertex] == -1 && dfs(vertex) {
+				size++
+			}
+		}
+	}
+	return mate, size, nil
+}
+
+// HopcroftKarp is a shorthand for HopcroftKarp(g)
+func (g *UndirectedGraph) HopcroftKarp() ([]int, int, error) {
+	return HopcroftKarp(g)
+}
+
+// Hungarian solves the assignment problem for the given cost matrix using the Hungarian (aka Kuhn-Munkres) algo
+// i.e. it assigns each row (e.g. worker) to a distinct column (e.g. job), minimizing the total cost.
+// The matrix may be rectangular; then the smaller side gets fully assigned.
+// A +Inf cost forbids the pair; if the smaller side can not be fully assigned without one, ERR_NO_FEASIBLE_ASSIGNMENT
+// is returned.
+// It returns the column assigned to each row (-1 if unassigned), and the total cost.
+// Time Complexity: O(n^2.m) for n x m matrix, n <= m
+func Hungarian(cost [][]float64) ([]int, float64, error) {
+	rows := len(cost)
+	if rows == 0 {
+		return []int{}, 0, nil
+	}
+	cols := len(cost[0])
+	for _, row := range cost {
+		if len(row) != cols {
+			return nil, 0, ERR_INVALID_COST_MATRIX
+		}
+		for _, c := range row {
+			if math.IsNaN(c) || math.IsInf(c, -1) {
+				return nil, 0, ERR_INVALID_COST_MATRIX
+			}
+		}
+	}
+
+	// the algo needs rows <= cols, so solve the transposed problem otherwise
+	if rows > cols {
+		transposed := make([][]float64, cols)
+		for j := range transposed {
+			transposed[j] = make([]float64, rows)
+			for i := range cost {
+				transposed[j][i] = cost[i][j]
+			}
+		}
+		colAssignment, total, err := Hungarian(transposed)
+		if err != nil {
+			return nil, 0, err
+		}
+		assignment := make([]int, rows)
+		for i := range assignment {
+			assignment[i] = -1
+		}
+		for j, i := range colAssignment {
+			assignment[i] = j
+		}
+		return assignment, total, nil
+	}
+
+	// the potentials of the rows & columns (1-indexed; the 0th column is a virtual one)
+	u := make([]float64, rows+1)
+	v := make([]float64, cols+1)
+	// the row assigned to each column, 0 if none
+	p := make([]int, cols+1)
+	// the previous column in the alternating path to each column
+	way := make([]int, cols+1)
+
+	for i := 1; i <= rows; i++ {
+		// assign the row i, via the virtual column 0
+		p[0] = i
+		j0 := 0
+		minv := make([]float64, cols+1)
+		used := make([]bool, cols+1)
+		for j := range minv {
+			minv[j] = math.Inf(1)
+		}
+		// grow the alternating path until it reaches an unassigned column
+		for p[j0] != 0 {
+			used[j0] = true
+			i0, delta, j1 := p[j0], math.Inf(1), 0
+			for j := 1; j <= cols; j++ {
+				if used[j] {
+					continue
+				}
+				if cur := cost[i0-1][j-1] - u[i0] - v[j]; cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			// all the columns left are forbidden to the rows on the path, so the row i can not be assigned
+			if j1 == 0 {
+				return nil, 0, ERR_NO_FEASIBLE_ASSIGNMENT
+			}
+			// update the potentials, keeping the reduced costs of the path at zero
+			for j := 0; j <= cols; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+		}
+		// flip the assignment along the path
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	assignment := make([]int, rows)
+	total := 0.0
+	for j := 1; j <= cols; j++ {
+		if p[j] != 0 {
+			assignment[p[j]-1] = j - 1
+			total += cost[p[j]-1][j-1]
+		}
+	}
+	return assignment, total, nil
+}
@@ -0,0 +1,130 @@
+/*
+matching_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUndirectedGraph_HopcroftKarp(t *testing.T) {
+	// workers 0..3, jobs 4..7
+	g := NewUndirectedGraph(8)
+	g.AddEdge(0, 4)
+	g.AddEdge(0, 5)
+	g.AddEdge(1, 4)
+	g.AddEdge(2, 5)
+	g.AddEdge(2, 6)
+	g.AddEdge(3, 6)
+
+	mate, size, err := g.HopcroftKarp()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, size)
+	matched := 0
+	for vertex, m := range mate {
+		if m == -1 {
+			continue
+		}
+		matched++
+		assert.Equal(t, vertex, mate[m])
+		_, ok := g.EdgeWeight(vertex, m)
+		assert.True(t, ok)
+	}
+	assert.Equal(t, 6, matched)
+	assert.Equal(t, -1, mate[7])
+
+	// a perfect matching, requiring augmentation through matched vertices
+	g.AddEdge(3, 7)
+	_, size, err = g.HopcroftKarp()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, size)
+
+	// an odd cycle
+	g = NewUndirectedGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	_, _, err = g.HopcroftKarp()
+	assert.Equal(t, ERR_GRAPH_IS_NOT_BIPARTITE, err)
+}
+
+func TestHungarian(t *testing.T) {
+	cost := [][]float64{
+		{4, 1, 3},
+		{2, 0, 5},
+		{3, 2, 2},
+	}
+	assignment, total, err := Hungarian(cost)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 0, 2}, assignment)
+	assert.Equal(t, 5.0, total)
+
+	// more jobs than workers
+	assignment, total, err = Hungarian([][]float64{
+		{9, 2, 7, 8},
+		{6, 4, 3, 7},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2}, assignment)
+	assert.Equal(t, 5.0, total)
+
+	// more workers than jobs
+	assignment, total, err = Hungarian([][]float64{
+		{9, 6},
+		{2, 4},
+		{7, 3},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{-1, 0, 1}, assignment)
+	assert.Equal(t, 5.0, total)
+
+	assignment, total, err = Hungarian(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{}, assignment)
+	assert.Equal(t, 0.0, total)
+
+	_, _, err = Hungarian([][]float64{{1, 2}, {3}})
+	assert.Equal(t, ERR_INVALID_COST_MATRIX, err)
+	_, _, err = Hungarian([][]float64{{1, math.NaN()}, {3, 4}})
+	assert.Equal(t, ERR_INVALID_COST_MATRIX, err)
+	_, _, err = Hungarian([][]float64{{1, math.Inf(-1)}, {3, 4}})
+	assert.Equal(t, ERR_INVALID_COST_MATRIX, err)
+}
+
+func TestHungarian_ForbiddenPairs(t *testing.T) {
+	inf := math.Inf(1)
+	// a +Inf cost forbids the pair
+	assignment, total, err := Hungarian([][]float64{
+		{inf, 1, 2},
+		{3, inf, 1},
+		{1, 1, inf},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 0}, assignment)
+	assert.Equal(t, 3.0, total)
+
+	// more workers than jobs
+	assignment, total, err = Hungarian([][]float64{
+		{inf, 5},
+		{inf, 2},
+		{4, inf},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{-1, 1, 0}, assignment)
+	assert.Equal(t, 6.0, total)
+
+	// the column 0 is forbidden to both the rows
+	_, _, err = Hungarian([][]float64{{inf, 1}, {inf, 2}})
+	assert.Equal(t, ERR_NO_FEASIBLE_ASSIGNMENT, err)
+
+	// the row 1 has no allowed column at all
+	_, _, err = Hungarian([][]float64{{1, 2, 3}, {inf, inf, inf}})
+	assert.Equal(t, ERR_NO_FEASIBLE_ASSIGNMENT, err)
+}
@@ -0,0 +1,109 @@
+/*
+mst.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements Minimum Spanning Tree algorithms on weighted Undirected Graphs
+
+package adt
+
+import (
+	"sort"
+
+	"github.com/toransahu/goutils/adt/heap"
+)
+
+// Kruskal finds a minimum spanning tree (MST) of the undirected graph using Kruskal's algo
+// i.e. it picks the edges in the increasing order of weight, skipping the ones which would make a cycle.
+// If the graph is disconnected, it finds a minimum spanning forest (a MST for each connected component).
+// It returns the edges of the tree (or forest), and their total weight.
+// Time Complexity: O(E log E)
+func Kruskal(g GraphInterface) ([]Edge, float64) {
+	edges := UndirectedEdges(g)
+	sort.SliceStable(edges, func(i, j int) bool { return edges[i].Weight < edges[j].Weight })
+
+	// to track the vertices already connected by the picked edges
+	connected := NewDisjointSet(g.NumVertices())
+	result := []Edge{}
+	total := 0.0
+	for _, edge := range edges {
+		// skip the edge if its vertices are connected already, as that would make a cycle
+		if !connected.Union(edge.From, edge.To) {
+			continue
+		}
+		result = append(result, edge)
+		total += edge.Weight
+	}
+	return result, total
+}
+
+// Kruskal is a shorthand for Kruskal(g)
+func (g *UndirectedGraph) Kruskal() ([]Edge, float64) {
+	return Kruskal(g)
+}
+
+// Prim finds a minimum spanning tree (MST) of the undirected graph using Prim's algo
+// i.e. it grows the tree from a vertex, always picking the lightest edge connecting the tree to a new vertex.
+// If the graph is disconnected, it finds a minimum spanning forest (a MST for each connected component).
+// It returns the edges of the tree (or forest), and their total weight.
+// Time Complexity: O(E log E)
+func Prim(g GraphInterface) ([]Edge, float64) {
+	// a memory map to flag the vertices already in the tree
+	inTree := map[int]bool{}
+	result := []Edge{}
+	total := 0.0
+
+	// a min-heap of the edges going out of the tree, used as a priority queue
+	pq := &edgeArray{}
+	addVertex := func(vertex int) {
+		inTree[vertex] = true
+		weights := g.EdgeWeights(vertex)
+		for idx, neighbor := range g.Neighbors(vertex) {
+			if !inTree[neighbor] {
+				heap.Insert(pq, Edge{From: vertex, To: neighbor, Weight: weights[idx]})
+			}
+		}
+	}
+
+	// as the graph may be disconnected, grow a tree from every vertex not yet in any tree
+	for root := 0; root < g.NumVertices(); root++ {
+		if inTree[root] {
+			continue
+		}
+		addVertex(root)
+		for pq.Len() > 0 {
+			edge := heap.DeleteTop(pq).(Edge)
+			// the heap may hold edges to the vertices added to the tree later on; skip them
+			if inTree[edge.To] {
+				continue
+			}
+			result = append(result, edge)
+			total += edge.Weight
+			addVertex(edge.To)
+		}
+	}
+	return result, total
+}
+
+// Prim is a shorthand for Prim(g)
+func (g *UndirectedGraph) Prim() ([]Edge, float64) {
+	return Prim(g)
+}
+
+// edgeArray implements heap.Interface for Edge items, ordered by weight
+type edgeArray []Edge
+
+func (a edgeArray) LessThan(i, j int) bool      { return a[i].Weight < a[j].Weight }
+func (a edgeArray) Len() int                    { return len(a) }
+func (a edgeArray) Swap(i, j int)               { a[i], a[j] = a[j], a[i] }
+func (a edgeArray) ItemAt(i int) interface{}    { return a[i] }
+func (a edgeArray) Set(i int, item interface{}) { a[i] = item.(Edge) }
+func (a *edgeArray) Push(item interface{})      { *a = append(*a, item.(Edge)) }
+func (a *edgeArray) Pop() interface{} {
+	lastIndex := len(*a) - 1
+	popped := (*a)[lastIndex]
+	*a = (*a)[0:lastIndex]
+	return popped
+}
@@ -0,0 +1,65 @@
+/*
+mst_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUndirectedGraph_MinimumSpanningTree(t *testing.T) {
+	/*
+		0 --1-- 1 --3-- 2
+		|     / |       |
+		4   2   5       6
+		|  /    |       |
+		3 --7-- 4       5 --1-- 6
+
+		7 (isolated)
+	*/
+	g := NewUndirectedGraph(8)
+	g.AddWeightedEdge(0, 1, 1)
+	g.AddWeightedEdge(1, 2, 3)
+	g.AddWeightedEdge(0, 3, 4)
+	g.AddWeightedEdge(1, 3, 2)
+	g.AddWeightedEdge(1, 4, 5)
+	g.AddWeightedEdge(3, 4, 7)
+	g.AddWeightedEdge(2, 5, 6)
+	g.AddWeightedEdge(5, 6, 1)
+	g.AddWeightedEdge(6, 6, 0)
+
+	// the MST is a single tree, as the graph is connected (but for the isolated vertex)
+	want := map[[2]int]bool{{0, 1}: true, {1, 3}: true, {1, 2}: true, {1, 4}: true, {2, 5}: true, {5, 6}: true}
+
+	for name, mst := range map[string]func() ([]Edge, float64){"Kruskal": g.Kruskal, "Prim": g.Prim} {
+		edges, total := mst()
+		assert.Equal(t, 18.0, total, name)
+		assert.Len(t, edges, len(want), name)
+		for _, edge := range edges {
+			u, v := edge.From, edge.To
+			if u > v {
+				u, v = v, u
+			}
+			assert.True(t, want[[2]int{u, v}], "%v: unexpected edge %v", name, edge)
+		}
+	}
+}
+
+func TestUndirectedGraph_MinimumSpanningForest(t *testing.T) {
+	g := NewUndirectedGraph(4)
+	g.AddWeightedEdge(0, 1, 2)
+	g.AddWeightedEdge(0, 1, 1)
+	g.AddWeightedEdge(2, 3, -1)
+
+	for name, mst := range map[string]func() ([]Edge, float64){"Kruskal": g.Kruskal, "Prim": g.Prim} {
+		edges, total := mst()
+		assert.Equal(t, 0.0, total, name)
+		assert.Len(t, edges, 2, name)
+	}
+}
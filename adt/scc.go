@@ -0,0 +1,144 @@
+/*
+scc.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements Strongly Connected Components of Graphs
+
+package adt
+
+// StronglyConnectedComponents finds the strongly connected components (SCC) of the directed graph using Tarjan's algo
+// A SCC is a maximal set of vertices where every vertex is reachable from every other vertex of the set.
+// It returns the component of each vertex, and the number of components.
+// The components are numbered in a topological order i.e. an edge u -> v implies component[u] <= component[v].
+// Time Complexity: O(V + E)
+func StronglyConnectedComponents(g GraphInterface) ([]int, int) {
+	t := &tarjanSCC{
+		graph:     g,
+		index:     make([]int, g.NumVertices()),
+		lowLink:   make([]int, g.NumVertices()),
+		stack:     NewStack(),
+		onStack:   map[int]bool{},
+		component: make([]int, g.NumVertices()),
+	}
+
+	// as this is a directed graph (and may be disconnected as well)
+	// there could be possibilities that a few vertices remain unreachable
+	// so in such case, iterate over all the vertices
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		// index 0 denotes a not yet visited vertex
+		if t.index[vertex] == 0 {
+			t.strongConnect(vertex)
+		}
+	}
+
+	// Tarjan's algo discovers the components in reverse topological order, so flip the numbering
+	for vertex, c := range t.component {
+		t.component[vertex] = t.count - 1 - c
+	}
+	return t.component, t.count
+}
+
+// StronglyConnectedComponents is a shorthand for StronglyConnectedComponents(g)
+func (g *Graph) StronglyConnectedComponents() ([]int, int) {
+	return StronglyConnectedComponents(g)
+}
+
+// Condensation builds the condensation of the directed graph i.e. the graph with one vertex per
+// strongly connected component, and an edge between two components if any of their vertices are connected.
+// The condensation is always a directed acyclic graph (DAG), so it can be sorted using TopoSort.
+// It returns the condensation, and the component (i.e. the vertex in the condensation) of each vertex.
+// In case of parallel edges between two components, the lightest one is kept.
+func Condensation(g GraphInterface) (*Graph, []int) {
+	component, count := StronglyConnectedComponents(g)
+	condensation := NewGraph(count)
+
+	// to hold the lightest edge between a pair of components
+	edges := map[[2]int]float64{}
+	// to keep the edges in a deterministic order
+	order := [][2]int{}
+	for u := 0; u < g.NumVertices(); u++ {
+		neighbors, weights := g.Neighbors(u), g.EdgeWeights(u)
+		for idx, v := range neighbors {
+			// skip the edges within a component
+			if component[u] == component[v] {
+				continue
+			}
+			edge := [2]int{component[u], component[v]}
+			weight, found := edges[edge]
+			if !found {
+				order = append(order, edge)
+			}
+			if w := weights[idx]; !found || w < weight {
+				edges[edge] = w
+			}
+		}
+	}
+	for _, edge := range order {
+		condensation.AddWeightedEdge(edge[0], edge[1], edges[edge])
+	}
+	return condensation, component
+}
+
+// Condensation is a shorthand for Condensation(g)
+func (g *Graph) Condensation() (*Graph, []int) {
+	return Condensation(g)
+}
+
+// tarjanSCC holds the state of Tarjan's SCC algo
+type tarjanSCC struct {
+	graph GraphInterface
+	// the order (starting from 1) in which each vertex is discovered
+	index   []int
+	counter int
+	// the lowest index reachable from each vertex, through its DFS subtree & at most one back edge
+	lowLink []int
+	// the vertices visited but not yet assigned to a component
+	stack   Stack
+	onStack map[int]bool
+	// the component of each vertex
+	component []int
+	count     int
+}
+
+// strongConnect (private func) runs the DFS of Tarjan's algo from the given vertex
+func (t *tarjanSCC) strongConnect(vertex int) {
+	t.counter++
+	t.index[vertex] = t.counter
+	t.lowLink[vertex] = t.counter
+	t.stack.Push(vertex)
+	t.onStack[vertex] = true
+
+	// iterate through all the adjacent vertices of the given vertex
+	for _, u := range t.graph.Neighbors(vertex) {
+		if t.index[u] == 0 {
+			// not yet visited, so visit it & inherit its low-link
+			t.strongConnect(u)
+			if t.lowLink[u] < t.lowLink[vertex] {
+				t.lowLink[vertex] = t.lowLink[u]
+			}
+		} else if t.onStack[u] && t.index[u] < t.lowLink[vertex] {
+			// a back edge to a vertex of the current component
+			t.lowLink[vertex] = t.index[u]
+		}
+	}
+
+	// if the vertex is the root of a component, pop the whole component off the stack
+	if t.lowLink[vertex] == t.index[vertex] {
+		for {
+			popped, err := t.stack.Pop()
+			if err != nil {
+				panic(err)
+			}
+			u := popped.(int)
+			t.onStack[u] = false
+			t.component[u] = t.count
+			if u == vertex {
+				break
+			}
+		}
+		t.count++
+	}
+}
@@ -0,0 +1,90 @@
+/*
+scc_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_StronglyConnectedComponents(t *testing.T) {
+	/*
+
+		0 --> 1 --> 3 --> 4
+		^    /      ^    /
+		|   v       |   v
+		  2         5 <-
+
+		6 (isolated)
+	*/
+	g := NewGraph(7)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(1, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 3)
+
+	component, count := g.StronglyConnectedComponents()
+	assert.Equal(t, 3, count)
+	assert.Equal(t, component[0], component[1])
+	assert.Equal(t, component[0], component[2])
+	assert.Equal(t, component[3], component[4])
+	assert.Equal(t, component[3], component[5])
+	assert.NotEqual(t, component[0], component[3])
+	assert.NotEqual(t, component[0], component[6])
+	assert.NotEqual(t, component[3], component[6])
+
+	// the components are numbered in topological order
+	for u, neighbors := range g.AdjacencyList {
+		for _, v := range neighbors {
+			assert.LessOrEqual(t, component[u], component[v])
+		}
+	}
+
+	// a DAG has one component per vertex
+	g = NewGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	component, count = g.StronglyConnectedComponents()
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []int{0, 1, 2}, component)
+}
+
+func TestGraph_Condensation(t *testing.T) {
+	g := NewGraph(6)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 0)
+	g.AddWeightedEdge(1, 2, 5)
+	g.AddWeightedEdge(0, 2, 3)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 2)
+	g.AddEdge(5, 3)
+
+	assert.True(t, g.IsCyclic())
+
+	condensation, component := g.Condensation()
+	assert.Equal(t, 3, condensation.Vertices)
+	assert.False(t, condensation.IsCyclic())
+
+	order, hasCycle := condensation.TopoSort()
+	assert.False(t, hasCycle)
+	assert.Len(t, order, 3)
+
+	// the parallel edges 1 -> 2 & 0 -> 2 are merged into the lightest one
+	weight, ok := condensation.EdgeWeight(component[0], component[2])
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, weight)
+	assert.Len(t, condensation.AdjacencyList[component[0]], 1)
+
+	_, ok = condensation.EdgeWeight(component[5], component[3])
+	assert.True(t, ok)
+}
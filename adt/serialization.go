@@ -0,0 +1,492 @@
+/*
+serialization.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements the serialization of Graphs as edge list, JSON & GraphML, preserving their attributes
+
+package adt
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	myerr "github.com/toransahu/goutils/errors"
+)
+
+var ERR_INVALID_EDGE_LIST myerr.UserDefinedError = "invalid edge list"
+var ERR_INVALID_GRAPHML myerr.UserDefinedError = "invalid GraphML"
+
+// WriteEdgeList writes the graph as a plain text edge list, e.g.
+//
+//	# the number of vertices
+//	3
+//	# the attributes of a vertex: vertex key=value...
+//	0 name=app
+//	# an edge: u v weight key=value...
+//	0 1 2.5 label=needs
+//	1 2 1
+//
+// The attribute keys & values are query-escaped (e.g. a space is written as +).
+// The attributes are written only for an AttributedGraphInterface (e.g. Graph & UndirectedGraph).
+func WriteEdgeList(w io.Writer, g GraphInterface) error {
+	vertexAttributes, edgeAttributes := attributesOf(g)
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, g.NumVertices())
+	for _, vertex := range sortedVertices(vertexAttributes) {
+		if len(vertexAttributes[vertex]) > 0 {
+			fmt.Fprintf(bw, "%d%s\n", vertex, edgeListAttributes(vertexAttributes[vertex]))
+		}
+	}
+	for u := 0; u < g.NumVertices(); u++ {
+		weights := g.EdgeWeights(u)
+		for idx, v := range g.Neighbors(u) {
+			weight := strconv.FormatFloat(weights[idx], 'g', -1, 64)
+			fmt.Fprintf(bw, "%d %d %s%s\n", u, v, weight, edgeListAttributes(edgeAttributes[[2]int{u, v}]))
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadEdgeList builds a Graph from an edge list written by WriteEdgeList
+// The blank lines & the lines starting with # are ignored. The weight of an edge is optional, defaulting to 1.
+func ReadEdgeList(r io.Reader) (*Graph, error) {
+	var g *Graph
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		// the first line holds the number of vertices
+		if g == nil {
+			n, err := strconv.Atoi(fields[0])
+			if err != nil || n < 0 || len(fields) != 1 {
+				return nil, edgeListError(line, "expected the number of vertices")
+			}
+			g = NewGraph(n)
+			continue
+		}
+
+		u, err := strconv.Atoi(fields[0])
+		if err != nil || u < 0 || u >= len(g.AdjacencyList) {
+			return nil, edgeListError(line, fmt.Sprintf("invalid vertex %q", fields[0]))
+		}
+		// a vertex line
+		if len(fields) == 1 || strings.Contains(fields[1], "=") {
+			attrs, err := parseEdgeListAttributes(fields[1:], line)
+			if err != nil {
+				return nil, err
+			}
+			for key, value := range attrs {
+				g.SetVertexAttribute(u, key, value)
+			}
+			continue
+		}
+		// an edge line
+		v, err := strconv.Atoi(fields[1])
+		if err != nil || v < 0 || v >= len(g.AdjacencyList) {
+			return nil, edgeListError(line, fmt.Sprintf("invalid vertex %q", fields[1]))
+		}
+		fields = fields[2:]
+		weight := 1.0
+		if len(fields) > 0 && !strings.Contains(fields[0], "=") {
+			if weight, err = strconv.ParseFloat(fields[0], 64); err != nil {
+				return nil, edgeListError(line, fmt.Sprintf("invalid weight %q", fields[0]))
+			}
+			fields = fields[1:]
+		}
+		attrs, err := parseEdgeListAttributes(fields, line)
+		if err != nil {
+			return nil, err
+		}
+		g.AddWeightedEdge(u, v, weight)
+		for key, value := range attrs {
+			g.SetEdgeAttribute(u, v, key, value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, edgeListError(line, "expected the number of vertices")
+	}
+	return g, nil
+}
+
+// edgeListAttributes (private func) formats the attributes as the space separated key=value pairs, sorted by key
+func edgeListAttributes(attrs Attributes) string {
+	var sb strings.Builder
+	for _, key := range sortedKeys(attrs) {
+		fmt.Fprintf(&sb, " %s=%s", url.QueryEscape(key), url.QueryEscape(attrs[key]))
+	}
+	return sb.String()
+}
+
+// parseEdgeListAttributes (private func) parses the key=value pairs
+func parseEdgeListAttributes(fields []string, line int) (Attributes, error) {
+	attrs := Attributes{}
+	for _, field := range fields {
+		pair := strings.SplitN(field, "=", 2)
+		if len(pair) != 2 {
+			return nil, edgeListError(line, fmt.Sprintf("expected key=value, got %q", field))
+		}
+		key, err := url.QueryUnescape(pair[0])
+		if err != nil {
+			return nil, edgeListError(line, fmt.Sprintf("invalid key %q", pair[0]))
+		}
+		value, err := url.QueryUnescape(pair[1])
+		if err != nil {
+			return nil, edgeListError(line, fmt.Sprintf("invalid value %q", pair[1]))
+		}
+		attrs[key] = value
+	}
+	return attrs, nil
+}
+
+// edgeListError (private func) returns an error wrapping ERR_INVALID_EDGE_LIST
+func edgeListError(line int, msg string) error {
+	return fmt.Errorf("%w: line %d: %s", ERR_INVALID_EDGE_LIST, line, msg)
+}
+
+// jsonGraph is the JSON representation of a Graph
+type jsonGraph struct {
+	Vertices         int                `json:"vertices"`
+	VertexAttributes map[int]Attributes `json:"vertexAttributes,omitempty"`
+	Edges            []jsonEdge         `json:"edges"`
+}
+
+// jsonEdge is the JSON representation of an edge of a Graph
+type jsonEdge struct {
+	From       int        `json:"from"`
+	To         int        `json:"to"`
+	Weight     jsonWeight `json:"weight"`
+	Attributes Attributes `json:"attributes,omitempty"`
+}
+
+// jsonWeight is the JSON representation of an edge weight
+// JSON has no numbers for +Inf, -Inf & NaN, so they are encoded as the strings "+Inf", "-Inf" & "NaN"
+// (the same as in the edge list).
+type jsonWeight float64
+
+// MarshalJSON implements json.Marshaler
+func (w jsonWeight) MarshalJSON() ([]byte, error) {
+	weight := float64(w)
+	if math.IsInf(weight, 0) || math.IsNaN(weight) {
+		return json.Marshal(strconv.FormatFloat(weight, 'g', -1, 64))
+	}
+	return json.Marshal(weight)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting either a number or one of the strings
+func (w *jsonWeight) UnmarshalJSON(b []byte) error {
+	var weight float64
+	if err := json.Unmarshal(b, &weight); err == nil {
+		*w = jsonWeight(weight)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("invalid weight %s", b)
+	}
+	weight, err := strconv.ParseFloat(s, 64)
+	if err != nil || !(math.IsInf(weight, 0) || math.IsNaN(weight)) {
+		return fmt.Errorf("invalid weight %s", b)
+	}
+	*w = jsonWeight(weight)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the graph as e.g.
+//
+//	{"vertices":2,"vertexAttributes":{"0":{"name":"app"}},"edges":[{"from":0,"to":1,"weight":2.5}]}
+func (g *Graph) MarshalJSON() ([]byte, error) {
+	data := jsonGraph{Vertices: len(g.AdjacencyList), Edges: []jsonEdge{}}
+	for vertex, attrs := range g.VertexAttributes {
+		if len(attrs) == 0 {
+			continue
+		}
+		if data.VertexAttributes == nil {
+			data.VertexAttributes = map[int]Attributes{}
+		}
+		data.VertexAttributes[vertex] = attrs
+	}
+	for u, neighbors := range g.AdjacencyList {
+		for idx, v := range neighbors {
+			data.Edges = append(data.Edges, jsonEdge{From: u, To: v, Weight: jsonWeight(g.weightAt(u, idx)), Attributes: g.EdgeAttributes[[2]int{u, v}]})
+		}
+	}
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the graph encoded by MarshalJSON
+func (g *Graph) UnmarshalJSON(b []byte) error {
+	var data jsonGraph
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	if data.Vertices < 0 {
+		return fmt.Errorf("%w: %d vertices", ERR_VERTEX_OUT_OF_RANGE, data.Vertices)
+	}
+	inRange := func(vertex int) bool {
+		return vertex >= 0 && vertex < data.Vertices
+	}
+
+	decoded := NewGraph(data.Vertices)
+	for vertex, attrs := range data.VertexAttributes {
+		if !inRange(vertex) {
+			return fmt.Errorf("%w: vertex %d", ERR_VERTEX_OUT_OF_RANGE, vertex)
+		}
+		for key, value := range attrs {
+			decoded.SetVertexAttribute(vertex, key, value)
+		}
+	}
+	for _, edge := range data.Edges {
+		if !inRange(edge.From) || !inRange(edge.To) {
+			return fmt.Errorf("%w: edge %d -> %d", ERR_VERTEX_OUT_OF_RANGE, edge.From, edge.To)
+		}
+		decoded.AddWeightedEdge(edge.From, edge.To, float64(edge.Weight))
+		for key, value := range edge.Attributes {
+			decoded.SetEdgeAttribute(edge.From, edge.To, key, value)
+		}
+	}
+	*g = *decoded
+	return nil
+}
+
+// graphML is the GraphML document, see http://graphml.graphdrawing.org
+type graphML struct {
+	XMLName xml.Name       `xml:"graphml"`
+	XMLNS   string         `xml:"xmlns,attr,omitempty"`
+	Keys    []graphMLKey   `xml:"key"`
+	Graphs  []graphMLGraph `xml:"graph"`
+}
+
+// graphMLKey declares an attribute of the nodes or the edges
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr,omitempty"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source   string        `xml:"source,attr"`
+	Target   string        `xml:"target,attr"`
+	Directed string        `xml:"directed,attr,omitempty"`
+	Data     []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// WriteGraphML writes the graph in the GraphML format
+// The vertex i is written as the node "n<i>". The weight is written as the edge attribute "weight",
+// so an edge attribute of the same name is not written.
+// The attributes are written only for an AttributedGraphInterface (e.g. Graph & UndirectedGraph).
+func WriteGraphML(w io.Writer, g GraphInterface) error {
+	vertexAttributes, edgeAttributes := attributesOf(g)
+	doc := graphML{XMLNS: "http://graphml.graphdrawing.org/xmlns"}
+	doc.Keys = append(doc.Keys, graphMLKey{ID: "weight", For: "edge", Name: "weight", Type: "double"})
+
+	// declare a key for every attribute name
+	vertexKeys, edgeKeys := map[string]string{}, map[string]string{}
+	vertexNames, edgeNames := map[string]bool{}, map[string]bool{}
+	for _, attrs := range vertexAttributes {
+		for name := range attrs {
+			vertexNames[name] = true
+		}
+	}
+	for _, attrs := range edgeAttributes {
+		for name := range attrs {
+			if name != "weight" {
+				edgeNames[name] = true
+			}
+		}
+	}
+	for idx, name := range sortedNames(vertexNames) {
+		vertexKeys[name] = "v" + strconv.Itoa(idx)
+		doc.Keys = append(doc.Keys, graphMLKey{ID: vertexKeys[name], For: "node", Name: name, Type: "string"})
+	}
+	for idx, name := range sortedNames(edgeNames) {
+		edgeKeys[name] = "e" + strconv.Itoa(idx)
+		doc.Keys = append(doc.Keys, graphMLKey{ID: edgeKeys[name], For: "edge", Name: name, Type: "string"})
+	}
+
+	graph := graphMLGraph{ID: "G", EdgeDefault: "directed"}
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		node := graphMLNode{ID: "n" + strconv.Itoa(vertex)}
+		attrs := vertexAttributes[vertex]
+		for _, name := range sortedKeys(attrs) {
+			node.Data = append(node.Data, graphMLData{Key: vertexKeys[name], Value: attrs[name]})
+		}
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	for u := 0; u < g.NumVertices(); u++ {
+		weights := g.EdgeWeights(u)
+		for idx, v := range g.Neighbors(u) {
+			edge := graphMLEdge{Source: "n" + strconv.Itoa(u), Target: "n" + strconv.Itoa(v)}
+			edge.Data = append(edge.Data, graphMLData{Key: "weight", Value: strconv.FormatFloat(weights[idx], 'g', -1, 64)})
+			attrs := edgeAttributes[[2]int{u, v}]
+			for _, name := range sortedKeys(attrs) {
+				if name != "weight" {
+					edge.Data = append(edge.Data, graphMLData{Key: edgeKeys[name], Value: attrs[name]})
+				}
+			}
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+	doc.Graphs = []graphMLGraph{graph}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// ReadGraphML builds a Graph from a GraphML document, having a single graph
+// The vertices are numbered in the order of the nodes. The edge attribute "weight" is the weight of an edge,
+// defaulting to 1. An undirected edge u - v is stored as u -> v & v -> u (as UndirectedGraph does).
+// Nested graphs, hyperedges & ports are not supported.
+func ReadGraphML(r io.Reader) (*Graph, error) {
+	var doc graphML
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ERR_INVALID_GRAPHML, err)
+	}
+	if len(doc.Graphs) != 1 {
+		return nil, fmt.Errorf("%w: expected a single graph, got %d", ERR_INVALID_GRAPHML, len(doc.Graphs))
+	}
+	graph := doc.Graphs[0]
+
+	// the attribute name of each key
+	vertexKeys, edgeKeys := map[string]string{}, map[string]string{}
+	for _, key := range doc.Keys {
+		if key.For == "node" || key.For == "all" {
+			vertexKeys[key.ID] = key.Name
+		}
+		if key.For == "edge" || key.For == "all" {
+			edgeKeys[key.ID] = key.Name
+		}
+	}
+
+	g := NewGraph(len(graph.Nodes))
+	ids := map[string]int{}
+	for vertex, node := range graph.Nodes {
+		if _, found := ids[node.ID]; found {
+			return nil, fmt.Errorf("%w: duplicate node %q", ERR_INVALID_GRAPHML, node.ID)
+		}
+		ids[node.ID] = vertex
+		for _, data := range node.Data {
+			name, found := vertexKeys[data.Key]
+			if !found {
+				return nil, fmt.Errorf("%w: undeclared node key %q", ERR_INVALID_GRAPHML, data.Key)
+			}
+			g.SetVertexAttribute(vertex, name, data.Value)
+		}
+	}
+	for _, edge := range graph.Edges {
+		u, uFound := ids[edge.Source]
+		v, vFound := ids[edge.Target]
+		if !uFound || !vFound {
+			return nil, fmt.Errorf("%w: edge between unknown nodes %q & %q", ERR_INVALID_GRAPHML, edge.Source, edge.Target)
+		}
+		weight := 1.0
+		attrs := Attributes{}
+		for _, data := range edge.Data {
+			name, found := edgeKeys[data.Key]
+			if !found {
+				return nil, fmt.Errorf("%w: undeclared edge key %q", ERR_INVALID_GRAPHML, data.Key)
+			}
+			if name != "weight" {
+				attrs[name] = data.Value
+				continue
+			}
+			var err error
+			if weight, err = strconv.ParseFloat(strings.TrimSpace(data.Value), 64); err != nil {
+				return nil, fmt.Errorf("%w: invalid weight %q", ERR_INVALID_GRAPHML, data.Value)
+			}
+		}
+		directed := edge.Directed == "true" || (edge.Directed == "" && graph.EdgeDefault != "undirected")
+		pairs := [][2]int{{u, v}}
+		if !directed && u != v {
+			pairs = append(pairs, [2]int{v, u})
+		}
+		for _, pair := range pairs {
+			g.AddWeightedEdge(pair[0], pair[1], weight)
+			for key, value := range attrs {
+				g.SetEdgeAttribute(pair[0], pair[1], key, value)
+			}
+		}
+	}
+	return g, nil
+}
+
+// attributesOf (private func) returns the vertex & edge attributes of the graph, nil maps if its type has none
+func attributesOf(g GraphInterface) (map[int]Attributes, map[[2]int]Attributes) {
+	if attributed, ok := g.(AttributedGraphInterface); ok {
+		return attributed.VertexAttributeMap(), attributed.EdgeAttributeMap()
+	}
+	return nil, nil
+}
+
+// sortedVertices (private func) returns the vertices having the attributes, in ascending order
+func sortedVertices(attributes map[int]Attributes) []int {
+	vertices := make([]int, 0, len(attributes))
+	for vertex := range attributes {
+		vertices = append(vertices, vertex)
+	}
+	sort.Ints(vertices)
+	return vertices
+}
+
+// sortedKeys (private func) returns the keys of the attributes, in ascending order
+func sortedKeys(attrs Attributes) []string {
+	keys := make([]string, 0, len(attrs))
+	for key := range attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortedNames (private func) returns the names of the set, in ascending order
+func sortedNames(set map[string]bool) []string {
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,222 @@
+/*
+serialization_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newAttributedGraph (private func) returns a graph having vertex & edge attributes, and parallel edges
+func newAttributedGraph() *Graph {
+	g := NewGraph(4)
+	g.AddWeightedEdge(0, 1, 2.5)
+	g.AddWeightedEdge(1, 2, -1)
+	g.AddWeightedEdge(0, 1, 4)
+	g.AddEdge(2, 0)
+	g.SetVertexAttribute(0, "name", "app server")
+	g.SetVertexAttribute(0, "owner", "a=b&c")
+	g.SetVertexAttribute(2, "name", "core")
+	g.SetEdgeAttribute(0, 1, "label", "needs")
+	g.SetEdgeAttribute(2, 0, "color", "red")
+	return g
+}
+
+// attributedCSRGraph is a CSRGraph having the vertex attributes, to test the AttributedGraphInterface
+type attributedCSRGraph struct {
+	*CSRGraph
+	vertexAttributes map[int]Attributes
+}
+
+func (g *attributedCSRGraph) VertexAttributeMap() map[int]Attributes  { return g.vertexAttributes }
+func (g *attributedCSRGraph) EdgeAttributeMap() map[[2]int]Attributes { return nil }
+
+func TestGraph_Attributes(t *testing.T) {
+	g := newAttributedGraph()
+	value, ok := g.VertexAttribute(0, "name")
+	assert.True(t, ok)
+	assert.Equal(t, "app server", value)
+	_, ok = g.VertexAttribute(1, "name")
+	assert.False(t, ok)
+	value, ok = g.EdgeAttribute(0, 1, "label")
+	assert.True(t, ok)
+	assert.Equal(t, "needs", value)
+
+	// removal drops & renumbers the attributes
+	g.RemoveEdge(2, 0)
+	_, ok = g.EdgeAttribute(2, 0, "color")
+	assert.False(t, ok)
+	g.RemoveVertex(1)
+	assert.Equal(t, map[int]Attributes{0: {"name": "app server", "owner": "a=b&c"}, 1: {"name": "core"}}, g.VertexAttributes)
+	assert.Equal(t, map[[2]int]Attributes{}, g.EdgeAttributes)
+
+	// an undirected edge shares its attributes in both directions
+	u := NewUndirectedGraph(2)
+	u.AddEdge(0, 1)
+	u.SetEdgeAttribute(1, 0, "label", "link")
+	value, ok = u.EdgeAttribute(0, 1, "label")
+	assert.True(t, ok)
+	assert.Equal(t, "link", value)
+}
+
+func TestEdgeList(t *testing.T) {
+	g := newAttributedGraph()
+	var buf bytes.Buffer
+	assert.Nil(t, WriteEdgeList(&buf, g))
+	assert.Equal(t, `4
+0 name=app+server owner=a%3Db%26c
+2 name=core
+0 1 2.5 label=needs
+0 1 4 label=needs
+1 2 -1
+2 0 1 color=red
+`, buf.String())
+
+	decoded, err := ReadEdgeList(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, g, decoded)
+
+	// comments, blank lines & the default weight
+	decoded, err = ReadEdgeList(strings.NewReader("# a graph\n\n2\n0 1\n1 0 label=back\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int{{1}, {0}}, decoded.AdjacencyList)
+	assert.Equal(t, [][]float64{{1}, {1}}, decoded.Weights)
+
+	// the graph types other than Graph have no attributes
+	c := NewCSRGraph(g)
+	buf.Reset()
+	assert.Nil(t, WriteEdgeList(&buf, c))
+	assert.Equal(t, "4\n0 1 2.5\n0 1 4\n1 2 -1\n2 0 1\n", buf.String())
+	m := NewAdjacencyMatrix(2)
+	m.AddWeightedEdge(1, 0, 3)
+	buf.Reset()
+	assert.Nil(t, WriteEdgeList(&buf, m))
+	assert.Equal(t, "2\n1 0 3\n", buf.String())
+
+	// any AttributedGraphInterface has its attributes written
+	buf.Reset()
+	assert.Nil(t, WriteEdgeList(&buf, &attributedCSRGraph{CSRGraph: c, vertexAttributes: g.VertexAttributes}))
+	assert.Equal(t, "4\n0 name=app+server owner=a%3Db%26c\n2 name=core\n0 1 2.5\n0 1 4\n1 2 -1\n2 0 1\n", buf.String())
+
+	for _, src := range []string{"", "two", "2\n0 2", "2\n0 1 heavy", "2\n0 name", "2\n0 name=%zz", "2\n-1 1"} {
+		_, err := ReadEdgeList(strings.NewReader(src))
+		assert.True(t, errors.Is(err, ERR_INVALID_EDGE_LIST), src)
+	}
+}
+
+func TestGraph_JSON(t *testing.T) {
+	g := newAttributedGraph()
+	b, err := json.Marshal(g)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"vertices":4,"vertexAttributes":{"0":{"name":"app server","owner":"a=b\u0026c"},"2":{"name":"core"}},`+
+		`"edges":[{"from":0,"to":1,"weight":2.5,"attributes":{"label":"needs"}},{"from":0,"to":1,"weight":4,"attributes":{"label":"needs"}},`+
+		`{"from":1,"to":2,"weight":-1},{"from":2,"to":0,"weight":1,"attributes":{"color":"red"}}]}`, string(b))
+
+	decoded := &Graph{}
+	assert.Nil(t, json.Unmarshal(b, decoded))
+	assert.Equal(t, g, decoded)
+
+	// as a field of a struct
+	snapshot := struct{ Deps *Graph }{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"Deps":{"vertices":2,"edges":[{"from":1,"to":0,"weight":3}]}}`), &snapshot))
+	assert.Equal(t, [][]int{{}, {0}}, snapshot.Deps.AdjacencyList)
+
+	err = json.Unmarshal([]byte(`{"vertices":1,"edges":[{"from":0,"to":1,"weight":1}]}`), decoded)
+	assert.True(t, errors.Is(err, ERR_VERTEX_OUT_OF_RANGE))
+
+	// the non-finite weights are encoded as strings
+	g = NewGraph(2)
+	g.AddWeightedEdge(0, 1, math.Inf(1))
+	g.AddWeightedEdge(1, 0, math.Inf(-1))
+	g.AddWeightedEdge(1, 1, math.NaN())
+	b, err = json.Marshal(g)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"vertices":2,"edges":[{"from":0,"to":1,"weight":"+Inf"},{"from":1,"to":0,"weight":"-Inf"},`+
+		`{"from":1,"to":1,"weight":"NaN"}]}`, string(b))
+	decoded = &Graph{}
+	assert.Nil(t, json.Unmarshal(b, decoded))
+	assert.Equal(t, g.AdjacencyList, decoded.AdjacencyList)
+	assert.Equal(t, []float64{math.Inf(1)}, decoded.Weights[0])
+	assert.Equal(t, math.Inf(-1), decoded.Weights[1][0])
+	assert.True(t, math.IsNaN(decoded.Weights[1][1]))
+
+	for _, weight := range []string{`"heavy"`, `"1.5"`, `true`} {
+		err = json.Unmarshal([]byte(`{"vertices":1,"edges":[{"from":0,"to":0,"weight":`+weight+`}]}`), decoded)
+		assert.NotNil(t, err, weight)
+	}
+}
+
+func TestGraphML(t *testing.T) {
+	g := newAttributedGraph()
+	var buf bytes.Buffer
+	assert.Nil(t, WriteGraphML(&buf, g))
+	assert.Contains(t, buf.String(), `<key id="weight" for="edge" attr.name="weight" attr.type="double"></key>`)
+	assert.Contains(t, buf.String(), `<key id="v0" for="node" attr.name="name" attr.type="string"></key>`)
+	assert.Contains(t, buf.String(), `<data key="v1">a=b&amp;c</data>`)
+
+	decoded, err := ReadGraphML(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, g, decoded)
+
+	// an UndirectedGraph is written with its attributes, as both the directions of each edge
+	u := NewUndirectedGraph(2)
+	u.AddWeightedEdge(0, 1, 2)
+	u.SetEdgeAttribute(0, 1, "label", "link")
+	buf.Reset()
+	assert.Nil(t, WriteGraphML(&buf, u))
+	decoded, err = ReadGraphML(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, &u.Graph, decoded)
+
+	// a CSRGraph is written without attributes
+	buf.Reset()
+	assert.Nil(t, WriteGraphML(&buf, NewCSRGraph(g)))
+	decoded, err = ReadGraphML(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int{{1, 1}, {2}, {0}, {}}, decoded.AdjacencyList)
+	assert.Equal(t, [][]float64{{2.5, 4}, {-1}, {1}, {}}, decoded.Weights)
+	assert.Nil(t, decoded.VertexAttributes)
+	assert.Nil(t, decoded.EdgeAttributes)
+
+	// an undirected graph with a default weight
+	src := `<?xml version="1.0"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="all" attr.name="label" attr.type="string"/>
+  <graph edgedefault="undirected">
+    <node id="a"><data key="d0">A</data></node>
+    <node id="b"/>
+    <edge source="a" target="b"><data key="d0">ab</data></edge>
+    <edge source="b" target="a" directed="true"/>
+  </graph>
+</graphml>`
+	decoded, err = ReadGraphML(strings.NewReader(src))
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int{{1}, {0, 0}}, decoded.AdjacencyList)
+	assert.Equal(t, [][]float64{{1}, {1, 1}}, decoded.Weights)
+	assert.Equal(t, map[int]Attributes{0: {"label": "A"}}, decoded.VertexAttributes)
+	value, _ := decoded.EdgeAttribute(1, 0, "label")
+	assert.Equal(t, "ab", value)
+
+	for _, src := range []string{
+		`<graphml>`,
+		`<graphml></graphml>`,
+		`<graphml><graph><node id="a"/><edge source="a" target="b"/></graph></graphml>`,
+		`<graphml><graph><node id="a"/><node id="a"/></graph></graphml>`,
+		`<graphml><graph><node id="a"><data key="x">1</data></node></graph></graphml>`,
+		`<graphml><key id="w" for="edge" attr.name="weight"/><graph><node id="a"/><edge source="a" target="a"><data key="w">x</data></edge></graph></graphml>`,
+	} {
+		_, err := ReadGraphML(strings.NewReader(src))
+		assert.True(t, errors.Is(err, ERR_INVALID_GRAPHML), src)
+	}
+}
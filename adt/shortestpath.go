@@ -0,0 +1,438 @@
+/*
+shortestpath.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements shortest path algorithms on weighted Graphs
+
+package adt
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/toransahu/goutils/adt/heap"
+	myerr "github.com/toransahu/goutils/errors"
+)
+
+var ERR_VERTEX_OUT_OF_RANGE myerr.UserDefinedError = "vertex is out of range"
+var ERR_NEGATIVE_EDGE_WEIGHT myerr.UserDefinedError = "graph has an edge with negative weight"
+var ERR_GRAPH_HAS_NEGATIVE_CYCLE myerr.UserDefinedError = "graph has a negative cycle"
+
+// NegativeCycleError is returned when the shortest paths are not defined due to a cycle of negative total weight
+// It holds the vertices of the cycle in the order of its edges (the first vertex is not repeated at the end).
+type NegativeCycleError struct {
+	Cycle []int
+}
+
+func (e *NegativeCycleError) Error() string {
+	return fmt.Sprintf("%s: %v", ERR_GRAPH_HAS_NEGATIVE_CYCLE, e.Cycle)
+}
+
+// Unwrap makes errors.Is(err, ERR_GRAPH_HAS_NEGATIVE_CYCLE) work for a NegativeCycleError
+func (e *NegativeCycleError) Unwrap() error {
+	return ERR_GRAPH_HAS_NEGATIVE_CYCLE
+}
+
+// Dijkstra finds the shortest (least weight) paths from the source vertex to all the vertices of the graph
+// It returns the distance of each vertex from the source (+Inf if unreachable), and
+// the predecessor of each vertex in the shortest path tree (-1 for the source & unreachable vertices).
+// Dijkstra does not work with negative edge weights; use BellmanFord instead.
+// Time Complexity: O((V + E) log V)
+func Dijkstra(g GraphInterface, source int) ([]float64, []int, error) {
+	if source < 0 || source >= g.NumVertices() {
+		return nil, nil, ERR_VERTEX_OUT_OF_RANGE
+	}
+	// pre-check: Dijkstra's greedy choice is wrong in presence of negative edges
+	if hasNegativeEdge(g) {
+		return nil, nil, ERR_NEGATIVE_EDGE_WEIGHT
+	}
+
+	distances, predecessors := dijkstra(g, source)
+	return distances, predecessors, nil
+}
+
+// Dijkstra is a shorthand for Dijkstra(g, source)
+func (g *Graph) Dijkstra(source int) ([]float64, []int, error) {
+	return Dijkstra(g, source)
+}
+
+// hasNegativeEdge (private func) tells whether the graph has an edge of negative weight
+func hasNegativeEdge(g GraphInterface) bool {
+	for u := 0; u < g.NumVertices(); u++ {
+		for _, weight := range g.EdgeWeights(u) {
+			if weight < 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dijkstra (private func) runs the Dijkstra algo, assuming the source is valid & there are no negative edges
+func dijkstra(g GraphInterface, source int) ([]float64, []int) {
+	return restrictedDijkstra(g, source, -1, nil)
+}
+
+// restrictedDijkstra (private func) runs the Dijkstra algo, ignoring the edges u -> v for which skip returns true
+// It stops as soon as the target is settled (-1 means no target), leaving the distances of the rest unsettled.
+func restrictedDijkstra(g GraphInterface, source int, target int, skip func(u int, v int) bool) ([]float64, []int) {
+	// to store the distance of each vertex from the source
+	distances := make([]float64, g.NumVertices())
+	// to store the predecessor of each vertex in the shortest path tree
+	predecessors := make([]int, g.NumVertices())
+	for vertex := range distances {
+		distances[vertex] = math.Inf(1)
+		predecessors[vertex] = -1
+	}
+	// a memory map to flag the vertices whose shortest distance is final
+	settled := map[int]bool{}
+
+	// a min-heap of the (vertex, distance) pairs, used as a priority queue
+	pq := &vertexDistanceArray{}
+	distances[source] = 0
+	heap.Insert(pq, vertexDistance{vertex: source, distance: 0})
+
+	for pq.Len() > 0 {
+		top := heap.DeleteTop(pq).(vertexDistance)
+		// the heap may hold stale entries of an already settled vertex (lazy deletion); skip them
+		if settled[top.vertex] {
+			continue
+		}
+		settled[top.vertex] = true
+		if top.vertex == target {
+			break
+		}
+
+		// relax all the outgoing edges of the vertex
+		weights := g.EdgeWeights(top.vertex)
+		for idx, neighbor := range g.Neighbors(top.vertex) {
+			if skip != nil && skip(top.vertex, neighbor) {
+				continue
+			}
+			distance := top.distance + weights[idx]
+			if distance < distances[neighbor] {
+				distances[neighbor] = distance
+				predecessors[neighbor] = top.vertex
+				heap.Insert(pq, vertexDistance{vertex: neighbor, distance: distance})
+			}
+		}
+	}
+	return distances, predecessors
+}
+
+// BellmanFord finds the shortest (least weight) paths from the source vertex to all the vertices of the graph
+// Unlike Dijkstra, it works with negative edge weights.
+// It returns the distance of each vertex from the source (+Inf if unreachable), and the predecessor of each vertex
+// in the shortest path tree (-1 for the source & unreachable vertices).
+// If a negative cycle is reachable from the source then shortest paths are not defined, so it returns
+// a *NegativeCycleError holding the vertices of such a cycle; see TopoSortWithError for the analogous *CycleError.
+// The cycle is reported via the error (unlike the ([]int, bool) of TopoSort & NegativeCycle), so that a bad source
+// is reported the same way, and the signature matches Dijkstra's for the callers switching between the two.
+// Time Complexity: O(V.E)
+func BellmanFord(g GraphInterface, source int) ([]float64, []int, error) {
+	if source < 0 || source >= g.NumVertices() {
+		return nil, nil, ERR_VERTEX_OUT_OF_RANGE
+	}
+	distances := make([]float64, g.NumVertices())
+	for vertex := range distances {
+		distances[vertex] = math.Inf(1)
+	}
+	distances[source] = 0
+
+	// only the vertices reachable from the source get a finite distance, so the cycle found is reachable as well
+	predecessors, cycle := bellmanFord(g, distances)
+	if cycle != nil {
+		return nil, nil, &NegativeCycleError{Cycle: cycle}
+	}
+	return distances, predecessors, nil
+}
+
+// BellmanFord is a shorthand for BellmanFord(g, source)
+func (g *Graph) BellmanFord(source int) ([]float64, []int, error) {
+	return BellmanFord(g, source)
+}
+
+// NegativeCycle finds a cycle whose total weight is negative, anywhere in the graph
+// It returns the vertices of the cycle in the order of its edges (the first vertex is not repeated at the end),
+// and whether such a cycle exists.
+// Time Complexity: O(V.E)
+func NegativeCycle(g GraphInterface) ([]int, bool) {
+	// start from all the vertices at once (as if from a virtual source having a 0 weight edge to every vertex)
+	// so that cycles unreachable from any particular vertex are detected as well
+	distances := make([]float64, g.NumVertices())
+
+	_, cycle := bellmanFord(g, distances)
+	if cycle == nil {
+		return nil, false
+	}
+	return cycle, true
+}
+
+// NegativeCycle is a shorthand for NegativeCycle(g)
+func (g *Graph) NegativeCycle() ([]int, bool) {
+	return NegativeCycle(g)
+}
+
+// bellmanFord (private func) runs the Bellman-Ford algo over the given initial distances, updating them in place
+// It returns the predecessors of each vertex, and the vertices of a negative cycle if one is found.
+func bellmanFord(g GraphInterface, distances []float64) ([]int, []int) {
+	predecessors := make([]int, g.NumVertices())
+	for vertex := range predecessors {
+		predecessors[vertex] = -1
+	}
+
+	// relax all the edges V times; a shortest path has at most V-1 edges
+	// so if an edge still gets relaxed in the V-th round, then there is a negative cycle
+	lastRelaxed := -1
+	for round := 0; round < g.NumVertices(); round++ {
+		lastRelaxed = -1
+		for u := 0; u < g.NumVertices(); u++ {
+			neighbors, weights := g.Neighbors(u), g.EdgeWeights(u)
+			if math.IsInf(distances[u], 1) {
+				continue
+			}
+			for idx, v := range neighbors {
+				distance := distances[u] + weights[idx]
+				if distance < distances[v] {
+					distances[v] = distance
+					predecessors[v] = u
+					lastRelaxed = v
+				}
+			}
+		}
+		// nothing changed in this round, so nothing would change in the later rounds either
+		if lastRelaxed == -1 {
+			return predecessors, nil
+		}
+	}
+
+	// the last relaxed vertex is either on a negative cycle or reachable from one
+	// so walk back V times through the predecessors to surely land on the cycle
+	vertex := lastRelaxed
+	for i := 0; i < g.NumVertices(); i++ {
+		vertex = predecessors[vertex]
+	}
+
+	// collect the cycle by walking back through the predecessors until we come back to the vertex
+	cycle := []int{vertex}
+	for u := predecessors[vertex]; u != vertex; u = predecessors[u] {
+		cycle = append(cycle, u)
+	}
+	// reverse the cycle to make it follow the direction of the edges
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return predecessors, cycle
+}
+
+// AllPairsShortestPaths finds the shortest (least weight) paths between every pair of vertices of the graph
+// It picks FloydWarshall for dense graphs and Johnson for sparse ones, based on the number of edges.
+// See FloydWarshall for the returned values.
+func AllPairsShortestPaths(g GraphInterface) ([][]float64, [][]int, bool) {
+	numOfVertices := float64(g.NumVertices())
+	numOfEdges := 0
+	for u := 0; u < g.NumVertices(); u++ {
+		numOfEdges += len(g.Neighbors(u))
+	}
+	// Johnson runs in O(V.E log V) & Floyd-Warshall in O(V^3)
+	// so Johnson wins only if E log V < V^2
+	if float64(numOfEdges)*math.Log2(numOfVertices+1) < numOfVertices*numOfVertices {
+		return Johnson(g)
+	}
+	return FloydWarshall(g)
+}
+
+// AllPairsShortestPaths is a shorthand for AllPairsShortestPaths(g)
+func (g *Graph) AllPairsShortestPaths() ([][]float64, [][]int, bool) {
+	return AllPairsShortestPaths(g)
+}
+
+// FloydWarshall finds the shortest (least weight) paths between every pair of vertices of the graph
+// It returns the distance matrix, where distances[u][v] is the distance from u to v (+Inf if unreachable), and
+// the next-hop matrix, where nextHops[u][v] is the vertex next to u in the shortest path from u to v (-1 if unreachable);
+// use NextHopPath to re-build the paths.
+// If the graph has a negative cycle then shortest paths are not defined, so it returns (nil, nil, true).
+// Time Complexity: O(V^3)
+func FloydWarshall(g GraphInterface) ([][]float64, [][]int, bool) {
+	distances, nextHops := newAllPairsMatrices(g)
+
+	// init the matrices with the direct edges (the lightest one, in case of parallel edges)
+	for u := 0; u < g.NumVertices(); u++ {
+		neighbors, weights := g.Neighbors(u), g.EdgeWeights(u)
+		for idx, v := range neighbors {
+			if weight := weights[idx]; weight < distances[u][v] {
+				distances[u][v] = weight
+				nextHops[u][v] = v
+			}
+		}
+	}
+
+	// allow the paths to go via the vertex k, one vertex at a time
+	for k := range distances {
+		for u := range distances {
+			if math.IsInf(distances[u][k], 1) {
+				continue
+			}
+			for v := range distances {
+				if distance := distances[u][k] + distances[k][v]; distance < distances[u][v] {
+					distances[u][v] = distance
+					nextHops[u][v] = nextHops[u][k]
+				}
+			}
+		}
+	}
+
+	// a vertex having a negative distance to itself is on a negative cycle
+	for vertex := range distances {
+		if distances[vertex][vertex] < 0 {
+			return nil, nil, true
+		}
+	}
+	return distances, nextHops, false
+}
+
+// FloydWarshall is a shorthand for FloydWarshall(g)
+func (g *Graph) FloydWarshall() ([][]float64, [][]int, bool) {
+	return FloydWarshall(g)
+}
+
+// Johnson finds the shortest (least weight) paths between every pair of vertices of the graph
+// It re-weights the edges (using Bellman-Ford) to make them non-negative, and then runs Dijkstra from every vertex.
+// See FloydWarshall for the returned values.
+// Time Complexity: O(V.E log V)
+func Johnson(g GraphInterface) ([][]float64, [][]int, bool) {
+	// compute the potential of each vertex, i.e. its distance from a virtual source
+	// having a 0 weight edge to every vertex
+	potentials := make([]float64, g.NumVertices())
+	if _, cycle := bellmanFord(g, potentials); cycle != nil {
+		return nil, nil, true
+	}
+
+	// re-weight the edges as w'(u, v) = w(u, v) + h(u) - h(v), which is never negative
+	// and preserves the shortest paths
+	reweighted := NewGraph(g.NumVertices())
+	for u := 0; u < g.NumVertices(); u++ {
+		neighbors, weights := g.Neighbors(u), g.EdgeWeights(u)
+		for idx, v := range neighbors {
+			reweighted.AddWeightedEdge(u, v, weights[idx]+potentials[u]-potentials[v])
+		}
+	}
+
+	distances, nextHops := newAllPairsMatrices(g)
+	for source := 0; source < g.NumVertices(); source++ {
+		sourceDistances, predecessors := dijkstra(reweighted, source)
+		for target, distance := range sourceDistances {
+			if math.IsInf(distance, 1) {
+				continue
+			}
+			// undo the re-weighting
+			distances[source][target] = distance - potentials[source] + potentials[target]
+			nextHops[source][target] = firstHop(predecessors, source, target)
+		}
+	}
+	return distances, nextHops, false
+}
+
+// Johnson is a shorthand for Johnson(g)
+func (g *Graph) Johnson() ([][]float64, [][]int, bool) {
+	return Johnson(g)
+}
+
+// newAllPairsMatrices (private func) creates the distance & next-hop matrices for the all-pairs shortest path algos
+// Every vertex is at distance 0 from itself, and rest all are unreachable.
+func newAllPairsMatrices(g GraphInterface) ([][]float64, [][]int) {
+	distances := make([][]float64, g.NumVertices())
+	nextHops := make([][]int, g.NumVertices())
+	for u := range distances {
+		distances[u] = make([]float64, g.NumVertices())
+		nextHops[u] = make([]int, g.NumVertices())
+		for v := range distances[u] {
+			distances[u][v] = math.Inf(1)
+			nextHops[u][v] = -1
+		}
+		distances[u][u] = 0
+		nextHops[u][u] = u
+	}
+	return distances, nextHops
+}
+
+// firstHop (private func) returns the vertex next to the source in the path to the target, as per the predecessor tree
+func firstHop(predecessors []int, source int, target int) int {
+	vertex := target
+	for vertex != source && predecessors[vertex] != source {
+		vertex = predecessors[vertex]
+	}
+	return vertex
+}
+
+// NextHopPath re-builds the path from u to v using the next-hop matrix returned by the all-pairs shortest path algos
+// It returns nil if v is not reachable from u.
+func NextHopPath(nextHops [][]int, u int, v int) []int {
+	if nextHops[u][v] == -1 {
+		return nil
+	}
+	path := []int{u}
+	for u != v {
+		u = nextHops[u][v]
+		path = append(path, u)
+	}
+	return path
+}
+
+// PathTo re-builds the path from the source (i.e. the root of the given predecessor tree) to the target vertex
+// It returns nil if the target is not reachable from the source. As the predecessor of both the source & the
+// unreachable vertices is -1, the source is needed to tell them apart. For a tree having many roots
+// (e.g. of a multi-source BFS), pass -1 as the source to accept any root; then it is up to the caller to check
+// the target is reachable at all (e.g. its distance), as an unreachable target is returned as the path [target].
+func PathTo(predecessors []int, source int, target int) []int {
+	if target < 0 || target >= len(predecessors) {
+		return nil
+	}
+	// walk back from the target to the root
+	path := []int{}
+	for vertex := target; vertex != -1; vertex = predecessors[vertex] {
+		path = append(path, vertex)
+		// guard against a malformed (cyclic) predecessor tree
+		if len(path) > len(predecessors) {
+			return nil
+		}
+	}
+	// the walk ended at a vertex other than the source i.e. an unreachable one
+	if source != -1 && path[len(path)-1] != source {
+		return nil
+	}
+	// reverse the path to make it root -> target
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+/*
+ HELPERS
+*/
+
+// vertexDistance is an item of the priority queue used by the shortest path algorithms
+type vertexDistance struct {
+	vertex   int
+	distance float64
+}
+
+// vertexDistanceArray implements heap.Interface for vertexDistance items
+type vertexDistanceArray []vertexDistance
+
+func (a vertexDistanceArray) LessThan(i, j int) bool      { return a[i].distance < a[j].distance }
+func (a vertexDistanceArray) Len() int                    { return len(a) }
+func (a vertexDistanceArray) Swap(i, j int)               { a[i], a[j] = a[j], a[i] }
+func (a vertexDistanceArray) ItemAt(i int) interface{}    { return a[i] }
+func (a vertexDistanceArray) Set(i int, item interface{}) { a[i] = item.(vertexDistance) }
+func (a *vertexDistanceArray) Push(item interface{})      { *a = append(*a, item.(vertexDistance)) }
+func (a *vertexDistanceArray) Pop() interface{} {
+	lastIndex := len(*a) - 1
+	popped := (*a)[lastIndex]
+	*a = (*a)[0:lastIndex]
+	return popped
+}
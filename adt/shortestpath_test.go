@@ -0,0 +1,203 @@
+/*
+shortestpath_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraph_AddWeightedEdge(t *testing.T) {
+	g := NewGraph(3)
+	g.AddEdge(0, 1)
+	g.AddWeightedEdge(1, 2, 2.5)
+	g.AddWeightedEdge(1, 2, 1.5)
+
+	weight, ok := g.EdgeWeight(0, 1)
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, weight)
+
+	// lightest of the parallel edges
+	weight, ok = g.EdgeWeight(1, 2)
+	assert.True(t, ok)
+	assert.Equal(t, 1.5, weight)
+
+	_, ok = g.EdgeWeight(2, 0)
+	assert.False(t, ok)
+}
+
+func TestGraph_Dijkstra(t *testing.T) {
+	/*
+		0 --4--> 1 --1--> 3
+		|        ^        ^
+		1        2        |
+		|        |        |
+		v        |        |
+		2 -------+---5----+
+
+		4 (unreachable)
+	*/
+	g := NewGraph(5)
+	g.AddWeightedEdge(0, 1, 4)
+	g.AddWeightedEdge(0, 2, 1)
+	g.AddWeightedEdge(2, 1, 2)
+	g.AddWeightedEdge(1, 3, 1)
+	g.AddWeightedEdge(2, 3, 5)
+
+	distances, predecessors, err := g.Dijkstra(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{0, 3, 1, 4, math.Inf(1)}, distances)
+	assert.Equal(t, []int{-1, 2, 0, 1, -1}, predecessors)
+
+	assert.Equal(t, []int{0, 2, 1, 3}, PathTo(predecessors, 0, 3))
+	assert.Equal(t, []int{0}, PathTo(predecessors, 0, 0))
+	assert.Nil(t, PathTo(predecessors, 0, 5))
+	// the vertex 4 is not reachable, though its predecessor is -1 like the source's
+	assert.Equal(t, -1, predecessors[4])
+	assert.Nil(t, PathTo(predecessors, 0, 4))
+	// any root is accepted
+	assert.Equal(t, []int{0, 2, 1, 3}, PathTo(predecessors, -1, 3))
+
+	_, _, err = g.Dijkstra(5)
+	assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err)
+
+	g.AddWeightedEdge(3, 4, -1)
+	_, _, err = g.Dijkstra(0)
+	assert.Equal(t, ERR_NEGATIVE_EDGE_WEIGHT, err)
+}
+
+func TestGraph_BellmanFord(t *testing.T) {
+	// a graph with a negative edge (a rebate) but no negative cycle
+	g := NewGraph(5)
+	g.AddWeightedEdge(0, 1, 4)
+	g.AddWeightedEdge(0, 2, 5)
+	g.AddWeightedEdge(1, 3, 3)
+	g.AddWeightedEdge(2, 1, -3)
+	g.AddWeightedEdge(3, 2, 2)
+
+	distances, predecessors, err := g.BellmanFord(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{0, 2, 5, 5, math.Inf(1)}, distances)
+	assert.Equal(t, []int{-1, 2, 0, 1, -1}, predecessors)
+	assert.Equal(t, []int{0, 2, 1, 3}, PathTo(predecessors, 0, 3))
+
+	cycle, hasNegativeCycle := g.NegativeCycle()
+	assert.False(t, hasNegativeCycle)
+	assert.Nil(t, cycle)
+
+	_, _, err = g.BellmanFord(5)
+	assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err)
+
+	// make the cycle 1 -> 3 -> 2 -> 1 negative: 3 + 2 - 3 - 3 < 0
+	g.AddWeightedEdge(3, 2, -3)
+
+	distances, predecessors, err = g.BellmanFord(0)
+	assert.True(t, errors.Is(err, ERR_GRAPH_HAS_NEGATIVE_CYCLE))
+	assert.Nil(t, distances)
+	assert.Nil(t, predecessors)
+	var negativeCycleErr *NegativeCycleError
+	assert.True(t, errors.As(err, &negativeCycleErr))
+	assertSameCycle(t, []int{1, 3, 2}, negativeCycleErr.Cycle)
+
+	cycle, hasNegativeCycle = g.NegativeCycle()
+	assert.True(t, hasNegativeCycle)
+	assertSameCycle(t, []int{1, 3, 2}, cycle)
+
+	// the negative cycle is not reachable from the source
+	distances, _, err = g.BellmanFord(4)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, distances[4])
+	assert.True(t, math.IsInf(distances[0], 1))
+
+	// only the negative cycle reachable from the source is reported: 5 -> 6 -> 5 but not 1 -> 3 -> 2 -> 1
+	g.AddVertex()
+	g.AddVertex()
+	g.AddWeightedEdge(4, 5, 1)
+	g.AddWeightedEdge(5, 6, -2)
+	g.AddWeightedEdge(6, 5, 1)
+	_, _, err = g.BellmanFord(4)
+	assert.True(t, errors.As(err, &negativeCycleErr))
+	assertSameCycle(t, []int{5, 6}, negativeCycleErr.Cycle)
+}
+
+func TestGraph_AllPairsShortestPaths(t *testing.T) {
+	inf := math.Inf(1)
+	g := NewGraph(4)
+	g.AddWeightedEdge(0, 1, 3)
+	g.AddWeightedEdge(0, 2, 8)
+	g.AddWeightedEdge(1, 2, -2)
+	g.AddWeightedEdge(2, 3, 1)
+	g.AddWeightedEdge(3, 0, 4)
+	g.AddWeightedEdge(3, 0, 6)
+
+	wantDistances := [][]float64{
+		{0, 3, 1, 2},
+		{3, 0, -2, -1},
+		{5, 8, 0, 1},
+		{4, 7, 5, 0},
+	}
+	wantNextHops := [][]int{
+		{0, 1, 1, 1},
+		{2, 1, 2, 2},
+		{3, 3, 2, 3},
+		{0, 0, 0, 3},
+	}
+
+	algos := map[string]func() ([][]float64, [][]int, bool){
+		"FloydWarshall":         g.FloydWarshall,
+		"Johnson":               g.Johnson,
+		"AllPairsShortestPaths": g.AllPairsShortestPaths,
+	}
+	for name, algo := range algos {
+		distances, nextHops, hasNegativeCycle := algo()
+		assert.False(t, hasNegativeCycle, name)
+		assert.Equal(t, wantDistances, distances, name)
+		assert.Equal(t, wantNextHops, nextHops, name)
+		assert.Equal(t, []int{3, 0, 1, 2}, NextHopPath(nextHops, 3, 2), name)
+		assert.Equal(t, []int{1}, NextHopPath(nextHops, 1, 1), name)
+	}
+
+	// an unreachable vertex
+	g = NewGraph(3)
+	g.AddWeightedEdge(0, 1, 2)
+	for name, algo := range map[string]func() ([][]float64, [][]int, bool){"FloydWarshall": g.FloydWarshall, "Johnson": g.Johnson} {
+		distances, nextHops, _ := algo()
+		assert.Equal(t, inf, distances[0][2], name)
+		assert.Nil(t, NextHopPath(nextHops, 0, 2), name)
+	}
+
+	// a negative cycle
+	g = NewGraph(2)
+	g.AddWeightedEdge(0, 1, 1)
+	g.AddWeightedEdge(1, 0, -2)
+	for name, algo := range map[string]func() ([][]float64, [][]int, bool){"FloydWarshall": g.FloydWarshall, "Johnson": g.Johnson} {
+		distances, nextHops, hasNegativeCycle := algo()
+		assert.True(t, hasNegativeCycle, name)
+		assert.Nil(t, distances, name)
+		assert.Nil(t, nextHops, name)
+	}
+}
+
+// assertSameCycle asserts that the given cycles are the same, irrespective of the vertex they start from
+func assertSameCycle(t *testing.T, want []int, got []int) {
+	t.Helper()
+	if !assert.Equal(t, len(want), len(got)) {
+		return
+	}
+	for shift := range got {
+		rotated := append(append([]int{}, got[shift:]...), got[:shift]...)
+		if reflect.DeepEqual(want, rotated) {
+			return
+		}
+	}
+	t.Errorf("wanted cycle: %v, got: %v", want, got)
+}
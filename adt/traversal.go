@@ -0,0 +1,385 @@
+/*
+traversal.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements the visitor based traversals (BFS & DFS) of Graphs
+// Like the other algorithms of the package (e.g. Dijkstra), the traversals from the given vertices return
+// ERR_VERTEX_OUT_OF_RANGE if any of them is out of range.
+
+package adt
+
+// EdgeKind denotes the classification of an edge by a traversal
+type EdgeKind int
+
+const (
+	TreeEdge    EdgeKind = iota // the edge discovering a new vertex
+	BackEdge                    // the edge to an ancestor (or the vertex itself) in the traversal tree
+	ForwardEdge                 // the edge to an already discovered descendant in the traversal tree (DFS only)
+	CrossEdge                   // any other edge e.g. to another subtree
+)
+
+func (k EdgeKind) String() string {
+	switch k {
+	case TreeEdge:
+		return "tree"
+	case BackEdge:
+		return "back"
+	case ForwardEdge:
+		return "forward"
+	case CrossEdge:
+		return "cross"
+	}
+	return "unknown"
+}
+
+// Visitor holds the callbacks of a traversal, each one is optional
+// A callback returns false to stop the traversal right away.
+type Visitor struct {
+	// DiscoverVertex is called when a vertex is reached for the first time
+	DiscoverVertex func(vertex int) bool
+	// ExamineEdge is called for every outgoing edge of a vertex, along with its classification
+	// A tree edge u -> v is examined before discovering v.
+	ExamineEdge func(u int, v int, kind EdgeKind) bool
+	// FinishVertex is called when all the outgoing edges of a vertex are examined
+	// (in case of DFS, along with the whole subtree of the vertex)
+	FinishVertex func(vertex int) bool
+}
+
+// vertex colors of a traversal
+const (
+	white = iota // not yet discovered
+	gray         // discovered but not yet finished
+	black        // finished
+)
+
+// traversal holds the state of a BFS or DFS
+type traversal struct {
+	graph   GraphInterface
+	visitor *Visitor
+	color   []int
+	// the order (starting from 1) in which each vertex is discovered
+	discovered []int
+	counter    int
+	// the parent & depth of each vertex in the BFS tree
+	parent []int
+	depth  []int
+}
+
+// newTraversal (private func) initializes the state of a traversal
+func newTraversal(g GraphInterface, visitor *Visitor) *traversal {
+	if visitor == nil {
+		visitor = &Visitor{}
+	}
+	return &traversal{
+		graph:      g,
+		visitor:    visitor,
+		color:      make([]int, g.NumVertices()),
+		discovered: make([]int, g.NumVertices()),
+	}
+}
+
+// discover (private func) marks the vertex as discovered & calls the visitor
+func (t *traversal) discover(vertex int) bool {
+	t.color[vertex] = gray
+	t.counter++
+	t.discovered[vertex] = t.counter
+	return t.visitor.DiscoverVertex == nil || t.visitor.DiscoverVertex(vertex)
+}
+
+// examine (private func) calls the visitor for the edge
+func (t *traversal) examine(u int, v int, kind EdgeKind) bool {
+	return t.visitor.ExamineEdge == nil || t.visitor.ExamineEdge(u, v, kind)
+}
+
+// finish (private func) marks the vertex as finished & calls the visitor
+func (t *traversal) finish(vertex int) bool {
+	t.color[vertex] = black
+	return t.visitor.FinishVertex == nil || t.visitor.FinishVertex(vertex)
+}
+
+// DFSVisit traverses the vertices reachable from the start vertex in Depth First Order, calling the visitor
+// It returns false if a callback stopped the traversal, otherwise true.
+// Time Complexity: O(V + E)
+func DFSVisit(g GraphInterface, start int, visitor *Visitor) (bool, error) {
+	if start < 0 || start >= g.NumVertices() {
+		return false, ERR_VERTEX_OUT_OF_RANGE
+	}
+	return newTraversal(g, visitor).dfs(start), nil
+}
+
+// DFSVisit is a shorthand for DFSVisit(g, start, visitor)
+func (g *Graph) DFSVisit(start int, visitor *Visitor) (bool, error) {
+	return DFSVisit(g, start, visitor)
+}
+
+// DFSVisitAll traverses all the vertices in Depth First Order, calling the visitor
+// As the graph may be disconnected, the traversal restarts from the lowest not yet discovered vertex, in the order of DFS.
+// It returns false if a callback stopped the traversal, otherwise true.
+// Time Complexity: O(V + E)
+func DFSVisitAll(g GraphInterface, visitor *Visitor) bool {
+	t := newTraversal(g, visitor)
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		if t.color[vertex] == white && !t.dfs(vertex) {
+			return false
+		}
+	}
+	return true
+}
+
+// DFSVisitAll is a shorthand for DFSVisitAll(g, visitor)
+func (g *Graph) DFSVisitAll(visitor *Visitor) bool {
+	return DFSVisitAll(g, visitor)
+}
+
+// dfs (private func) runs the DFS from the given vertex, and tells whether it was not stopped
+func (t *traversal) dfs(vertex int) bool {
+	if !t.discover(vertex) {
+		return false
+	}
+	// iterate through all the adjacent vertices of the given vertex
+	for _, u := range t.graph.Neighbors(vertex) {
+		var kind EdgeKind
+		switch {
+		case t.color[u] == white:
+			kind = TreeEdge
+		case t.color[u] == gray:
+			// u is still on the recursion stack i.e. an ancestor
+			kind = BackEdge
+		case t.discovered[vertex] < t.discovered[u]:
+			// u is finished, and was discovered after the vertex i.e. a descendant
+			kind = ForwardEdge
+		default:
+			kind = CrossEdge
+		}
+		if !t.examine(vertex, u, kind) {
+			return false
+		}
+		if kind == TreeEdge && !t.dfs(u) {
+			return false
+		}
+	}
+	return t.finish(vertex)
+}
+
+// BFSVisit traverses the vertices reachable from the start vertex in Breadth First Order, calling the visitor
+// A non-tree edge u -> v is classified as a back edge if v is an ancestor of u in the BFS tree, else as a cross edge.
+// It returns false if a callback stopped the traversal, otherwise true.
+// Time Complexity: O(V + E), plus the walk up the BFS tree for classifying each non-tree edge
+func BFSVisit(g GraphInterface, start int, visitor *Visitor) (bool, error) {
+	if start < 0 || start >= g.NumVertices() {
+		return false, ERR_VERTEX_OUT_OF_RANGE
+	}
+	return newTraversal(g, visitor).bfs(start), nil
+}
+
+// BFSVisit is a shorthand for BFSVisit(g, start, visitor)
+func (g *Graph) BFSVisit(start int, visitor *Visitor) (bool, error) {
+	return BFSVisit(g, start, visitor)
+}
+
+// BFSVisitAll traverses all the vertices in Breadth First Order, calling the visitor
+// As the graph may be disconnected, the traversal restarts from the lowest not yet discovered vertex.
+// It returns false if a callback stopped the traversal, otherwise true.
+func BFSVisitAll(g GraphInterface, visitor *Visitor) bool {
+	t := newTraversal(g, visitor)
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		if t.color[vertex] == white && !t.bfs(vertex) {
+			return false
+		}
+	}
+	return true
+}
+
+// BFSVisitAll is a shorthand for BFSVisitAll(g, visitor)
+func (g *Graph) BFSVisitAll(visitor *Visitor) bool {
+	return BFSVisitAll(g, visitor)
+}
+
+// bfs (private func) runs the BFS from the given vertex, and tells whether it was not stopped
+func (t *traversal) bfs(start int) bool {
+	if t.parent == nil {
+		t.parent = make([]int, t.graph.NumVertices())
+		t.depth = make([]int, t.graph.NumVertices())
+	}
+	t.parent[start], t.depth[start] = -1, 0
+	if !t.discover(start) {
+		return false
+	}
+
+	q := NewQueue()
+	q.Enqueue(start)
+	for !q.IsEmpty() {
+		n, err := q.Dequeue()
+		if err != nil {
+			panic(err)
+		}
+		vertex := n.(int)
+		for _, u := range t.graph.Neighbors(vertex) {
+			kind := CrossEdge
+			if t.color[u] == white {
+				kind = TreeEdge
+			} else if t.isAncestor(u, vertex) {
+				kind = BackEdge
+			}
+			if !t.examine(vertex, u, kind) {
+				return false
+			}
+			if kind != TreeEdge {
+				continue
+			}
+			t.parent[u], t.depth[u] = vertex, t.depth[vertex]+1
+			if !t.discover(u) {
+				return false
+			}
+			q.Enqueue(u)
+		}
+		if !t.finish(vertex) {
+			return false
+		}
+	}
+	return true
+}
+
+// isAncestor (private func) tells whether the ancestor is the vertex itself or an ancestor of it in the BFS tree
+func (t *traversal) isAncestor(ancestor int, vertex int) bool {
+	// walk up the BFS tree, till the depth of the ancestor
+	for vertex != -1 && t.depth[vertex] >= t.depth[ancestor] {
+		if vertex == ancestor {
+			return true
+		}
+		vertex = t.parent[vertex]
+	}
+	return false
+}
+
+// IsReachable tells whether the target vertex is reachable from the source vertex, stopping as soon as it is found
+// Time Complexity: O(V + E)
+func IsReachable(g GraphInterface, source int, target int) (bool, error) {
+	if target < 0 || target >= g.NumVertices() {
+		return false, ERR_VERTEX_OUT_OF_RANGE
+	}
+	found := false
+	_, err := BFSVisit(g, source, &Visitor{
+		DiscoverVertex: func(vertex int) bool {
+			found = vertex == target
+			return !found
+		},
+	})
+	return found, err
+}
+
+// IsReachable is a shorthand for IsReachable(g, source, target)
+func (g *Graph) IsReachable(source int, target int) (bool, error) {
+	return IsReachable(g, source, target)
+}
+
+// BFS runs the Breadth First Search from the given sources (at distance 0) i.e. a multi-source BFS
+// It returns the hop distance of every vertex from its nearest source (-1 if unreachable), and the predecessor
+// of every vertex in the BFS tree (-1 for the sources & the unreachable vertices), which can be walked using PathTo:
+// from a single source, pass it to PathTo; from many sources, pass -1 (i.e. any root) to PathTo for the path from
+// the nearest source, but only if the target is reachable (its distance is not -1), as PathTo can't tell otherwise.
+// Time Complexity: O(V + E)
+func BFS(g GraphInterface, sources ...int) ([]int, []int, error) {
+	distances := make([]int, g.NumVertices())
+	predecessors := make([]int, g.NumVertices())
+	for vertex := range distances {
+		distances[vertex] = -1
+		predecessors[vertex] = -1
+	}
+
+	q := NewQueue()
+	for _, source := range sources {
+		if source < 0 || source >= g.NumVertices() {
+			return nil, nil, ERR_VERTEX_OUT_OF_RANGE
+		}
+		if distances[source] == -1 {
+			distances[source] = 0
+			q.Enqueue(source)
+		}
+	}
+	for !q.IsEmpty() {
+		n, err := q.Dequeue()
+		if err != nil {
+			panic(err)
+		}
+		vertex := n.(int)
+		for _, u := range g.Neighbors(vertex) {
+			if distances[u] != -1 {
+				continue
+			}
+			distances[u] = distances[vertex] + 1
+			predecessors[u] = vertex
+			q.Enqueue(u)
+		}
+	}
+	return distances, predecessors, nil
+}
+
+// BFS is a shorthand for BFS(g, sources...)
+func (g *Graph) BFS(sources ...int) ([]int, []int, error) {
+	return BFS(g, sources...)
+}
+
+// BFSLayers groups the vertices reachable from the given sources by their hop distance
+// i.e. the layer i holds the vertices (in ascending order) at distance i from their nearest source.
+// Time Complexity: O(V + E)
+func BFSLayers(g GraphInterface, sources ...int) ([][]int, error) {
+	distances, _, err := BFS(g, sources...)
+	if err != nil {
+		return nil, err
+	}
+	layers := [][]int{}
+	// as the vertices are iterated in ascending order, each layer is sorted too
+	for vertex, distance := range distances {
+		if distance == -1 {
+			continue
+		}
+		for len(layers) <= distance {
+			layers = append(layers, []int{})
+		}
+		layers[distance] = append(layers[distance], vertex)
+	}
+	return layers, nil
+}
+
+// BFSLayers is a shorthand for BFSLayers(g, sources...)
+func (g *Graph) BFSLayers(sources ...int) ([][]int, error) {
+	return BFSLayers(g, sources...)
+}
+
+// ShortestPath finds a path from u to v having the fewest edges (irrespective of the weights), using BFS
+// It returns the vertices of the path (from u to v), or nil if v is not reachable from u.
+// The BFS stops as soon as v is reached.
+// Time Complexity: O(V + E)
+func ShortestPath(g GraphInterface, u int, v int) ([]int, error) {
+	if u < 0 || u >= g.NumVertices() || v < 0 || v >= g.NumVertices() {
+		return nil, ERR_VERTEX_OUT_OF_RANGE
+	}
+	predecessors := make([]int, g.NumVertices())
+	predecessors[u] = -1
+	found := false
+	BFSVisit(g, u, &Visitor{
+		ExamineEdge: func(from int, to int, kind EdgeKind) bool {
+			if kind == TreeEdge {
+				predecessors[to] = from
+			}
+			return true
+		},
+		DiscoverVertex: func(vertex int) bool {
+			found = vertex == v
+			return !found
+		},
+	})
+	if !found {
+		return nil, nil
+	}
+	return PathTo(predecessors, u, v), nil
+}
+
+// ShortestPath is a shorthand for ShortestPath(g, u, v)
+func (g *Graph) ShortestPath(u int, v int) ([]int, error) {
+	return ShortestPath(g, u, v)
+}
@@ -0,0 +1,230 @@
+/*
+traversal_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingVisitor (private func) returns a visitor recording every event
+func recordingVisitor(events *[]string) *Visitor {
+	return &Visitor{
+		DiscoverVertex: func(vertex int) bool {
+			*events = append(*events, fmt.Sprintf("discover %d", vertex))
+			return true
+		},
+		ExamineEdge: func(u int, v int, kind EdgeKind) bool {
+			*events = append(*events, fmt.Sprintf("%s %d->%d", kind, u, v))
+			return true
+		},
+		FinishVertex: func(vertex int) bool {
+			*events = append(*events, fmt.Sprintf("finish %d", vertex))
+			return true
+		},
+	}
+}
+
+func TestGraph_DFSVisit(t *testing.T) {
+	/*
+		0 -> 1 -> 2 -> 0 (back)
+		0 -> 2 (forward)
+		3 -> 1 (cross)
+	*/
+	g := NewGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(0, 2)
+	g.AddEdge(3, 1)
+
+	events := []string{}
+	assert.True(t, g.DFSVisitAll(recordingVisitor(&events)))
+	assert.Equal(t, []string{
+		"discover 0",
+		"tree 0->1",
+		"discover 1",
+		"tree 1->2",
+		"discover 2",
+		"back 2->0",
+		"finish 2",
+		"finish 1",
+		"forward 0->2",
+		"finish 0",
+		"discover 3",
+		"cross 3->1",
+		"finish 3",
+	}, events)
+
+	// start from a chosen vertex
+	events = []string{}
+	completed, err := g.DFSVisit(2, &Visitor{DiscoverVertex: func(vertex int) bool {
+		events = append(events, fmt.Sprint(vertex))
+		return true
+	}})
+	assert.Nil(t, err)
+	assert.True(t, completed)
+	assert.Equal(t, []string{"2", "0", "1"}, events)
+
+	// stop early
+	visited := 0
+	assert.False(t, DFSVisitAll(g, &Visitor{DiscoverVertex: func(vertex int) bool {
+		visited++
+		return vertex != 1
+	}}))
+	assert.Equal(t, 2, visited)
+
+	// a nil visitor
+	completed, err = g.DFSVisit(0, nil)
+	assert.Nil(t, err)
+	assert.True(t, completed)
+	_, err = g.DFSVisit(4, nil)
+	assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err)
+}
+
+func TestGraph_BFSVisit(t *testing.T) {
+	/*
+		0 -> 1 -> 3 -> 0 (back)
+		0 -> 2 -> 3 (cross)
+		2 -> 2 (back)
+	*/
+	g := NewGraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 3)
+	g.AddEdge(2, 2)
+	g.AddEdge(3, 0)
+	g.AddEdge(4, 3)
+
+	events := []string{}
+	completed, err := g.BFSVisit(0, recordingVisitor(&events))
+	assert.Nil(t, err)
+	assert.True(t, completed)
+	assert.Equal(t, []string{
+		"discover 0",
+		"tree 0->1",
+		"discover 1",
+		"tree 0->2",
+		"discover 2",
+		"finish 0",
+		"tree 1->3",
+		"discover 3",
+		"finish 1",
+		"cross 2->3",
+		"back 2->2",
+		"finish 2",
+		"back 3->0",
+		"finish 3",
+	}, events)
+
+	// the remaining vertex, whose edge goes to another tree
+	events = []string{}
+	assert.True(t, BFSVisitAll(g, recordingVisitor(&events)))
+	assert.Equal(t, []string{"discover 4", "cross 4->3", "finish 4"}, events[len(events)-3:])
+
+	// stop early on examining an edge
+	examined := 0
+	completed, err = g.BFSVisit(0, &Visitor{ExamineEdge: func(u int, v int, kind EdgeKind) bool {
+		examined++
+		return kind == TreeEdge
+	}})
+	assert.Nil(t, err)
+	assert.False(t, completed)
+	assert.Equal(t, 4, examined)
+
+	_, err = BFSVisit(g, -1, nil)
+	assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err)
+}
+
+func TestGraph_IsReachable(t *testing.T) {
+	g := NewGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(3, 0)
+
+	for _, tc := range []struct {
+		source, target int
+		want           bool
+	}{{0, 2, true}, {3, 2, true}, {2, 2, true}, {2, 0, false}, {0, 3, false}} {
+		reachable, err := IsReachable(g, tc.source, tc.target)
+		assert.Nil(t, err)
+		assert.Equal(t, tc.want, reachable, tc)
+	}
+
+	_, err := g.IsReachable(4, 0)
+	assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err)
+	_, err = g.IsReachable(0, 4)
+	assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err)
+}
+
+func TestGraph_BFS(t *testing.T) {
+	/*
+		0 - 1 - 2 - 3 - 4   5 (isolated)
+		with the healthy nodes 0 & 4
+	*/
+	g := NewUndirectedGraph(6)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+
+	distances, predecessors, err := g.BFS(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, -1}, distances)
+	assert.Equal(t, []int{-1, 0, 1, 2, 3, -1}, predecessors)
+	assert.Equal(t, []int{0, 1, 2, 3}, PathTo(predecessors, 0, 3))
+	assert.Nil(t, PathTo(predecessors, 0, 5))
+
+	// distance to the nearest healthy node
+	distances, predecessors, err = BFS(g, 0, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1, 2, 1, 0, -1}, distances)
+	assert.Equal(t, []int{4, 3}, PathTo(predecessors, -1, 3))
+	layers, err := g.BFSLayers(0, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int{{0, 4}, {1, 3}, {2}}, layers)
+
+	// no source
+	distances, _, err = BFS(g)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{-1, -1, -1, -1, -1, -1}, distances)
+	layers, err = BFSLayers(g)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]int{}, layers)
+
+	_, _, err = BFS(g, 0, 6)
+	assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err)
+	_, err = BFSLayers(g, -1)
+	assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err)
+}
+
+func TestGraph_ShortestPath(t *testing.T) {
+	g := NewGraph(5)
+	g.AddWeightedEdge(0, 1, 1)
+	g.AddWeightedEdge(1, 2, 1)
+	g.AddWeightedEdge(2, 3, 1)
+	// fewer edges, though heavier
+	g.AddWeightedEdge(0, 3, 10)
+
+	for _, tc := range []struct {
+		u, v int
+		want []int
+	}{{0, 3, []int{0, 3}}, {1, 3, []int{1, 2, 3}}, {2, 2, []int{2}}, {3, 0, nil}, {0, 4, nil}} {
+		path, err := ShortestPath(g, tc.u, tc.v)
+		assert.Nil(t, err)
+		assert.Equal(t, tc.want, path, tc)
+	}
+
+	for _, vertices := range [][2]int{{5, 0}, {-1, 0}, {0, 5}} {
+		_, err := g.ShortestPath(vertices[0], vertices[1])
+		assert.Equal(t, ERR_VERTEX_OUT_OF_RANGE, err, vertices)
+	}
+}
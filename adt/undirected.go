@@ -0,0 +1,241 @@
+/*
+undirected.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+// This file implements Undirected Graphs
+
+package adt
+
+// UndirectedGraph denotes an Undirected Graph data structure, implemented using Adjacency List
+// Every edge u - v is stored as a pair of directed edges u -> v & v -> u (a self loop v - v is stored once),
+// so the traversals of Graph (e.g. DFS) work as is. However, the methods meant for directed graphs only
+// (e.g. TopoSort, IsCyclic_V2, IsCyclic_V3) see every edge as a cycle of length 2.
+type UndirectedGraph struct {
+	Graph
+}
+
+// NewUndirectedGraph creates & returns an Undirected Graph implemented using Adjacency List
+func NewUndirectedGraph(numOfVertices int) *UndirectedGraph {
+	return &UndirectedGraph{Graph: *NewGraph(numOfVertices)}
+}
+
+// Undirected creates & returns an undirected copy of the directed graph i.e. ignoring the direction of the edges
+// The edges between the same pair of vertices (e.g. u -> v & v -> u) are merged into one, keeping the lightest weight.
+func Undirected(g GraphInterface) *UndirectedGraph {
+	undirected := NewUndirectedGraph(g.NumVertices())
+
+	// to hold the lightest edge between a pair of vertices
+	edges := map[[2]int]float64{}
+	// to keep the edges in a deterministic order
+	order := [][2]int{}
+	for u := 0; u < g.NumVertices(); u++ {
+		neighbors, weights := g.Neighbors(u), g.EdgeWeights(u)
+		for idx, v := range neighbors {
+			pair := [2]int{u, v}
+			if u > v {
+				pair = [2]int{v, u}
+			}
+			weight, found := edges[pair]
+			if !found {
+				order = append(order, pair)
+			}
+			if w := weights[idx]; !found || w < weight {
+				edges[pair] = w
+			}
+		}
+	}
+	for _, pair := range order {
+		undirected.AddWeightedEdge(pair[0], pair[1], edges[pair])
+	}
+	return undirected
+}
+
+// Undirected is a shorthand for Undirected(g)
+func (g *Graph) Undirected() *UndirectedGraph {
+	return Undirected(g)
+}
+
+// AddEdge inserts edge to the undirected graph
+func (g *UndirectedGraph) AddEdge(u int, v int) {
+	g.AddWeightedEdge(u, v, 1)
+}
+
+// AddWeightedEdge inserts an edge of the given weight (aka cost) to the undirected graph
+func (g *UndirectedGraph) AddWeightedEdge(u int, v int, weight float64) {
+	g.Graph.AddWeightedEdge(u, v, weight)
+	if u != v {
+		g.Graph.AddWeightedEdge(v, u, weight)
+	}
+}
+
+// SetEdgeAttribute sets the attribute of the edge u - v (shared by the parallel edges, if any)
+func (g *UndirectedGraph) SetEdgeAttribute(u int, v int, key string, value string) {
+	g.Graph.SetEdgeAttribute(u, v, key, value)
+	g.Graph.SetEdgeAttribute(v, u, key, value)
+}
+
+// RemoveEdge deletes the edge u - v (all of them, in case of parallel edges), and tells whether it existed
+func (g *UndirectedGraph) RemoveEdge(u int, v int) bool {
+	removed := g.Graph.RemoveEdge(u, v)
+	if u != v {
+		g.Graph.RemoveEdge(v, u)
+	}
+	return removed
+}
+
+// UndirectedEdges returns all the edges of the undirected graph, each one once (as From <= To)
+// The graph is expected to store every edge u - v as u -> v & v -> u, as UndirectedGraph does.
+func UndirectedEdges(g GraphInterface) []Edge {
+	edges := []Edge{}
+	for u := 0; u < g.NumVertices(); u++ {
+		neighbors, weights := g.Neighbors(u), g.EdgeWeights(u)
+		for idx, v := range neighbors {
+			// every edge u - v is stored as u -> v & v -> u, so pick only one of them
+			if u <= v {
+				edges = append(edges, Edge{From: u, To: v, Weight: weights[idx]})
+			}
+		}
+	}
+	return edges
+}
+
+// Edges is a shorthand for UndirectedEdges(g)
+func (g *UndirectedGraph) Edges() []Edge {
+	return UndirectedEdges(g)
+}
+
+// Degree returns the number of edges incident to the vertex (a self loop is counted twice)
+func (g *UndirectedGraph) Degree(vertex int) int {
+	degree := len(g.AdjacencyList[vertex])
+	for _, u := range g.AdjacencyList[vertex] {
+		if u == vertex {
+			degree++
+		}
+	}
+	return degree
+}
+
+// IsCyclicUndirected detects cycle in the undirected graph using DFS
+// Unlike a directed graph, reaching an already visited vertex via any edge other than the one
+// just traversed (i.e. the edge to the parent) means a cycle. Parallel edges & self loops are cycles too.
+func IsCyclicUndirected(g GraphInterface) bool {
+	// a memory map to flag the visited vertices
+	visited := map[int]bool{}
+	// as the graph may be disconnected, iterate over all the vertices
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		if visited[vertex] {
+			continue
+		}
+		if isCyclicUndirected(g, vertex, -1, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCyclic is a shorthand for IsCyclicUndirected(g)
+func (g *UndirectedGraph) IsCyclic() bool {
+	return IsCyclicUndirected(g)
+}
+
+func isCyclicUndirected(g GraphInterface, vertex int, parent int, visited map[int]bool) bool {
+	// mark the given vertex as visited
+	visited[vertex] = true
+
+	// whether the edge back to the parent (the one we came through) has been skipped already
+	skippedParentEdge := false
+	// iterate through all the adjacent vertices of the given vertex
+	for _, u := range g.Neighbors(vertex) {
+		if u == parent && !skippedParentEdge {
+			skippedParentEdge = true
+			continue
+		}
+		// an already visited vertex reachable via another edge closes a cycle
+		if visited[u] {
+			return true
+		}
+		if isCyclicUndirected(g, u, vertex, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// ConnectedComponents finds the connected components of the undirected graph using DFS
+// It returns the component of each vertex (numbered from 0, in the order of their lowest vertex),
+// and the number of components.
+// Time Complexity: O(V + E)
+func ConnectedComponents(g GraphInterface) ([]int, int) {
+	component := make([]int, g.NumVertices())
+	count := 0
+	// a memory map to flag the visited vertices
+	visited := map[int]bool{}
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		if visited[vertex] {
+			continue
+		}
+		// all the vertices reachable from the vertex form a component
+		members := []int{}
+		dfs(g, vertex, &visited, &members)
+		for _, member := range members {
+			component[member] = count
+		}
+		count++
+	}
+	return component, count
+}
+
+// ConnectedComponents is a shorthand for ConnectedComponents(g)
+func (g *UndirectedGraph) ConnectedComponents() ([]int, int) {
+	return ConnectedComponents(g)
+}
+
+// IsBipartite checks whether the undirected graph is bipartite, using BFS
+// i.e. whether its vertices can be colored with 2 colors, such that no edge connects the vertices of same color.
+// It returns the color (0 or 1) of each vertex, and true; or nil and false if the graph is not bipartite.
+// Time Complexity: O(V + E)
+func IsBipartite(g GraphInterface) ([]int, bool) {
+	colors := make([]int, g.NumVertices())
+	for vertex := range colors {
+		// -1 denotes a not yet colored vertex
+		colors[vertex] = -1
+	}
+
+	// as the graph may be disconnected, iterate over all the vertices
+	for vertex := 0; vertex < g.NumVertices(); vertex++ {
+		if colors[vertex] != -1 {
+			continue
+		}
+		colors[vertex] = 0
+		q := NewQueue()
+		q.Enqueue(vertex)
+		for !q.IsEmpty() {
+			n, err := q.Dequeue()
+			if err != nil {
+				panic(err)
+			}
+			node := n.(int)
+			for _, neighbor := range g.Neighbors(node) {
+				// color the neighbor with the opposite color
+				if colors[neighbor] == -1 {
+					colors[neighbor] = 1 - colors[node]
+					q.Enqueue(neighbor)
+					continue
+				}
+				// the neighbor has the same color, so an odd cycle exists
+				if colors[neighbor] == colors[node] {
+					return nil, false
+				}
+			}
+		}
+	}
+	return colors, true
+}
+
+// IsBipartite is a shorthand for IsBipartite(g)
+func (g *UndirectedGraph) IsBipartite() ([]int, bool) {
+	return IsBipartite(g)
+}
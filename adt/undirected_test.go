@@ -0,0 +1,94 @@
+/*
+undirected_test.go
+Copyright (C) 2021 Toran Sahu <toran.sahu@yahoo.com>
+
+Distributed under terms of the MIT license.
+*/
+
+package adt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUndirectedGraph_AddEdge(t *testing.T) {
+	g := NewUndirectedGraph(3)
+	g.AddEdge(0, 1)
+	g.AddWeightedEdge(1, 2, 2.5)
+	g.AddEdge(2, 2)
+
+	assert.Equal(t, [][]int{{1}, {0, 2}, {1, 2}}, g.AdjacencyList)
+	weight, ok := g.EdgeWeight(2, 1)
+	assert.True(t, ok)
+	assert.Equal(t, 2.5, weight)
+
+	assert.Equal(t, 1, g.Degree(0))
+	assert.Equal(t, 2, g.Degree(1))
+	assert.Equal(t, 3, g.Degree(2))
+
+	// traversal is shared with Graph
+	assert.Equal(t, []int{0, 1, 2}, g.DFS())
+
+	assert.True(t, g.RemoveEdge(2, 1))
+	assert.True(t, g.RemoveEdge(2, 2))
+	assert.False(t, g.RemoveEdge(1, 2))
+	assert.Equal(t, [][]int{{1}, {0}, {}}, g.AdjacencyList)
+}
+
+func TestUndirectedGraph_IsCyclic(t *testing.T) {
+	// a tree
+	g := NewUndirectedGraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(2, 3)
+	assert.False(t, g.IsCyclic())
+	// while the directed cycle detection sees every edge as a cycle
+	assert.True(t, g.Graph.IsCyclic())
+
+	g.AddEdge(3, 1)
+	assert.True(t, g.IsCyclic())
+
+	// parallel edges
+	g = NewUndirectedGraph(2)
+	g.AddEdge(0, 1)
+	assert.False(t, g.IsCyclic())
+	g.AddEdge(1, 0)
+	assert.True(t, g.IsCyclic())
+
+	// self loop
+	g = NewUndirectedGraph(1)
+	g.AddEdge(0, 0)
+	assert.True(t, g.IsCyclic())
+}
+
+func TestUndirectedGraph_ConnectedComponents(t *testing.T) {
+	g := NewUndirectedGraph(6)
+	g.AddEdge(0, 3)
+	g.AddEdge(3, 5)
+	g.AddEdge(1, 2)
+
+	component, count := g.ConnectedComponents()
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []int{0, 1, 1, 0, 2, 0}, component)
+}
+
+func TestUndirectedGraph_IsBipartite(t *testing.T) {
+	// an even cycle & an isolated vertex
+	g := NewUndirectedGraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 0)
+
+	colors, ok := g.IsBipartite()
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 1, 0, 1, 0}, colors)
+
+	// an odd cycle
+	g.AddEdge(0, 2)
+	colors, ok = g.IsBipartite()
+	assert.False(t, ok)
+	assert.Nil(t, colors)
+}